@@ -44,11 +44,13 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -116,6 +118,15 @@ var (
 	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error) = protoV5ProviderFactoriesInit(context.Background(), ProviderName)
 )
 
+// ProtoV6ProviderFactoriesEcho is a static map containing only the echo provider instance.
+//
+// Use it together with ConfigWithEchoProvider to check the result of an ephemeral resource.
+var (
+	ProtoV6ProviderFactoriesEcho map[string]func() (tfprotov6.ProviderServer, error) = map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -141,12 +152,16 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			if isEmulatorEnabled() {
+				primary.ConfigureContextFunc = emulatorProviderConfigureContextFunc(primary.ConfigureContextFunc)
+			}
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -166,6 +181,10 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		if isEmulatorEnabled() {
+			p.ConfigureContextFunc = emulatorProviderConfigureContextFunc(p.ConfigureContextFunc)
+		}
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -188,6 +207,10 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		if isEmulatorEnabled() {
+			p.ConfigureContextFunc = emulatorProviderConfigureContextFunc(p.ConfigureContextFunc)
+		}
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -278,6 +301,20 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		// No AWS account is needed to replay recorded interactions.
+		if isVCRReplaying() {
+			preCheckVCRReplaying(ctx, t)
+
+			return
+		}
+
+		// No AWS account is needed to run against a local AWS emulator.
+		if isEmulatorEnabled() {
+			preCheckEmulator(ctx, t)
+
+			return
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
@@ -1744,6 +1781,10 @@ func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 			t.Skipf("skipping test for %s/%s: %s", Partition(), Region(), err.Error())
 		}
 
+		if isEmulatorEnabled() && isEmulatorUnsupportedMessage(err.Error()) {
+			t.Skipf("skipping test: not supported by emulator (%s): %s", emulatorEndpoint(), err.Error())
+		}
+
 		return err
 	}
 }
@@ -1899,6 +1940,18 @@ data "aws_service" "provider_test" {
 }
 `
 
+// ConfigWithEchoProvider returns a configuration in which the echo provider echoes the specified value,
+// usually an ephemeral resource's result, into the data attribute of the echo.test resource.
+func ConfigWithEchoProvider(ephemeralResourceData string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, ephemeralResourceData)
+}
+
 // ConfigCompose can be called to concatenate multiple strings to build test configurations
 func ConfigCompose(config ...string) string {
 	var str strings.Builder
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// emulatorService is the service endpoint metadata used to run acceptance tests against a local AWS emulator.
+type emulatorService struct {
+	aliases           []string
+	apiCall           string
+	apiParams         string
+	packageName       string
+	providerNameUpper string
+	sdkID             string
+}
+
+// emulatorUnsupportedMessages are error message fragments returned by AWS emulators for services or API operations
+// that they do not implement.
+var emulatorUnsupportedMessages = []string{
+	"has not been implemented",
+	"not implemented",
+	"NotImplemented",
+	"not yet implemented",
+}
+
+var (
+	// emulatorServices returns the services whose endpoints can be configured, read from names data.
+	emulatorServices = sync.OnceValues(func() ([]emulatorService, error) {
+		serviceData, err := data.ReadAllServiceData()
+
+		if err != nil {
+			return nil, fmt.Errorf("reading service data: %w", err)
+		}
+
+		attributes := Provider.Schema["endpoints"].Elem.(*schema.Resource).Schema
+		var services []emulatorService
+
+		for _, sr := range serviceData {
+			if sr.Exclude() {
+				continue
+			}
+
+			if sr.NotImplemented() && !sr.EndpointOnly() {
+				continue
+			}
+
+			if _, ok := attributes[sr.ProviderPackage()]; !ok {
+				continue
+			}
+
+			services = append(services, emulatorService{
+				aliases:           sr.Aliases(),
+				apiCall:           sr.EndpointAPICall(),
+				apiParams:         sr.EndpointAPIParams(),
+				packageName:       sr.ProviderPackage(),
+				providerNameUpper: sr.ProviderNameUpper(),
+				sdkID:             sr.SDKID(),
+			})
+		}
+
+		return services, nil
+	})
+
+	// emulatorProbes caches the results of probing the emulator for service support, keyed by service ID.
+	emulatorProbes = struct {
+		sync.Mutex
+		results map[string]error
+	}{
+		results: make(map[string]error),
+	}
+)
+
+func emulatorEndpoint() string {
+	return os.Getenv(envvar.AccEmulatorEndpoint)
+}
+
+// isEmulatorEnabled returns whether acceptance tests are run against a local AWS emulator.
+func isEmulatorEnabled() bool {
+	return emulatorEndpoint() != ""
+}
+
+// emulatorEndpoints returns the provider's `endpoints` configuration with the endpoint of each service that is not
+// already configured, either directly or via one of its aliases, set to the emulator endpoint.
+func emulatorEndpoints(tfMap map[string]any, endpoint string, services []emulatorService) map[string]any {
+	result := make(map[string]any, len(services))
+	maps.Copy(result, tfMap)
+
+	for _, service := range services {
+		configured := func(k string) bool {
+			v, _ := tfMap[k].(string)
+			return v != ""
+		}
+
+		if configured(service.packageName) || slices.ContainsFunc(service.aliases, configured) {
+			continue
+		}
+
+		result[service.packageName] = endpoint
+	}
+
+	return result
+}
+
+// emulatorProviderConfigureContextFunc returns a provider configuration function that points all service endpoints
+// at the emulator before configuring the provider.
+func emulatorProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		services, err := emulatorServices()
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		var tfMap map[string]any
+		if v, ok := d.Get("endpoints").(*schema.Set); ok && v.Len() > 0 {
+			tfMap, _ = v.List()[0].(map[string]any)
+		}
+
+		if err := d.Set("endpoints", []any{emulatorEndpoints(tfMap, emulatorEndpoint(), services)}); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "setting endpoints: %s", err)
+		}
+
+		// Emulators don't support virtual-hosted-style S3 requests to arbitrary endpoints.
+		if _, ok := d.GetOk("s3_use_path_style"); !ok {
+			if err := d.Set("s3_use_path_style", true); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "setting s3_use_path_style: %s", err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// preCheckEmulator configures the "main" provider instance to use the emulator.
+// Placeholder credentials are used if none are configured.
+func preCheckEmulator(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+		os.Setenv(envvar.AccessKeyId, "test")
+		os.Setenv(envvar.SecretAccessKey, "test")
+	}
+	os.Setenv(envvar.DefaultRegion, Region())
+
+	Provider.ConfigureContextFunc = emulatorProviderConfigureContextFunc(Provider.ConfigureContextFunc)
+
+	diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+}
+
+// PreCheckEmulatorServices skips the test if it is run against a local AWS emulator that does not support
+// any of the specified services. Each service is probed by calling the API operation used by the service's
+// generated endpoint tests.
+func PreCheckEmulatorServices(ctx context.Context, t *testing.T, serviceIDs ...string) {
+	t.Helper()
+
+	if !isEmulatorEnabled() {
+		return
+	}
+
+	PreCheck(ctx, t)
+
+	services, err := emulatorServices()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, serviceID := range serviceIDs {
+		i := slices.IndexFunc(services, func(v emulatorService) bool {
+			return v.sdkID == serviceID
+		})
+
+		if i == -1 {
+			continue
+		}
+
+		if err := emulatorProbeServiceCached(ctx, Provider.Meta().(*conns.AWSClient), services[i]); err != nil {
+			t.Skipf("skipping test: %s is not supported by emulator (%s): %s", serviceID, emulatorEndpoint(), err)
+		}
+	}
+}
+
+func emulatorProbeServiceCached(ctx context.Context, meta *conns.AWSClient, service emulatorService) error {
+	emulatorProbes.Lock()
+	defer emulatorProbes.Unlock()
+
+	if err, ok := emulatorProbes.results[service.sdkID]; ok {
+		return err
+	}
+
+	err := emulatorProbeService(ctx, meta, service)
+	emulatorProbes.results[service.sdkID] = err
+
+	return err
+}
+
+// emulatorProbeService calls the service's endpoint test API operation, as used by the generated service endpoint tests,
+// and returns an error if the emulator does not support it.
+// Services whose API operation requires parameters, or that have no AWS SDK for Go v2 API client, are not probed.
+func emulatorProbeService(ctx context.Context, meta *conns.AWSClient, service emulatorService) error {
+	if service.apiCall == "" || service.apiParams != "" {
+		return nil
+	}
+
+	client := reflect.ValueOf(meta).MethodByName(service.providerNameUpper + "Client")
+
+	if !client.IsValid() {
+		return nil
+	}
+
+	operation := client.Call([]reflect.Value{reflect.ValueOf(ctx)})[0].MethodByName(service.apiCall)
+
+	if !operation.IsValid() || operation.Type().NumIn() < 2 || operation.Type().NumOut() != 2 {
+		return nil
+	}
+
+	input := reflect.New(operation.Type().In(1).Elem())
+	results := operation.Call([]reflect.Value{reflect.ValueOf(ctx), input})
+
+	if err, ok := results[1].Interface().(error); ok && isEmulatorUnsupportedError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// isEmulatorUnsupportedError returns whether the error indicates that the emulator does not support an API operation.
+func isEmulatorUnsupportedError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var e interface{ HTTPStatusCode() int }
+	if errors.As(err, &e) && e.HTTPStatusCode() == http.StatusNotImplemented {
+		return true
+	}
+
+	return isEmulatorUnsupportedMessage(err.Error())
+}
+
+// isEmulatorUnsupportedMessage returns whether the error message indicates that the emulator does not support an API operation.
+// Errors received from the SDK testing framework are strings, not AWS SDK for Go error types.
+func isEmulatorUnsupportedMessage(message string) bool {
+	return slices.ContainsFunc(emulatorUnsupportedMessages, func(v string) bool {
+		return strings.Contains(message, v)
+	})
+}
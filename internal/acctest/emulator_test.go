@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEmulatorEndpoints(t *testing.T) {
+	t.Parallel()
+
+	const endpoint = "http://localhost:4566"
+	services := []acctest.EmulatorService{
+		acctest.NewEmulatorService("dynamodb"),
+		acctest.NewEmulatorService("lambda"),
+		acctest.NewEmulatorService("s3", "s3api"),
+		acctest.NewEmulatorService("sqs"),
+	}
+
+	testCases := map[string]struct {
+		configured map[string]any
+		expected   map[string]any
+	}{
+		"none configured": {
+			expected: map[string]any{
+				"dynamodb": endpoint,
+				"lambda":   endpoint,
+				"s3":       endpoint,
+				"sqs":      endpoint,
+			},
+		},
+		"empty values": {
+			configured: map[string]any{
+				"dynamodb": "",
+				"lambda":   "",
+				"s3":       "",
+				"s3api":    "",
+				"sqs":      "",
+			},
+			expected: map[string]any{
+				"dynamodb": endpoint,
+				"lambda":   endpoint,
+				"s3":       endpoint,
+				"s3api":    "",
+				"sqs":      endpoint,
+			},
+		},
+		"service configured": {
+			configured: map[string]any{
+				"sqs": "http://localhost:9324",
+			},
+			expected: map[string]any{
+				"dynamodb": endpoint,
+				"lambda":   endpoint,
+				"s3":       endpoint,
+				"sqs":      "http://localhost:9324",
+			},
+		},
+		"alias configured": {
+			configured: map[string]any{
+				"s3":    "",
+				"s3api": "http://localhost:9000",
+			},
+			expected: map[string]any{
+				"dynamodb": endpoint,
+				"lambda":   endpoint,
+				"s3":       "",
+				"s3api":    "http://localhost:9000",
+				"sqs":      endpoint,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.EmulatorEndpoints(testCase.configured, endpoint, services)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEmulatorUnsupportedError(t *testing.T) {
+	t.Parallel()
+
+	responseError := func(statusCode int, err error) error {
+		return &smithy.OperationError{
+			ServiceID:     "SQS",
+			OperationName: "ListQueues",
+			Err: &awshttp.ResponseError{
+				ResponseError: &smithyhttp.ResponseError{
+					Response: &smithyhttp.Response{
+						Response: &http.Response{
+							StatusCode: statusCode,
+						},
+					},
+					Err: err,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {},
+		"other error": {
+			err: errors.New("AccessDenied: User is not authorized"),
+		},
+		"HTTP 501": {
+			err:      responseError(http.StatusNotImplemented, errors.New("InternalFailure")),
+			expected: true,
+		},
+		"HTTP 400": {
+			err: responseError(http.StatusBadRequest, errors.New("ValidationException")),
+		},
+		"LocalStack": {
+			err:      responseError(http.StatusInternalServerError, errors.New("API action 'ListQueues' for service 'sqs' not yet implemented or pro feature")),
+			expected: true,
+		},
+		"Moto": {
+			err:      errors.New("The list_queues action has not been implemented"),
+			expected: true,
+		},
+		"error code": {
+			err:      errors.New("api error NotImplemented: A header you provided implies functionality that is not implemented"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.IsEmulatorUnsupportedError(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsEmulatorUnsupportedError = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -5,5 +5,20 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder           = closeVCRRecorder
+	EmulatorEndpoints          = emulatorEndpoints
+	IsEmulatorUnsupportedError = isEmulatorUnsupportedError
+	NewVCRRecorder             = newVCRRecorder
+	VCRBodiesMatch             = vcrBodiesMatch
+	VCRRedactInteraction       = vcrRedactInteraction
+	VCRURLsMatch               = vcrURLsMatch
 )
+
+type EmulatorService = emulatorService
+
+func NewEmulatorService(packageName string, aliases ...string) EmulatorService {
+	return emulatorService{
+		aliases:     aliases,
+		packageName: packageName,
+	}
+}
@@ -4,10 +4,13 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // AttrImportStateIdFunc is a resource.ImportStateIdFunc that returns the value of the specified attribute
@@ -21,3 +24,26 @@ func AttrImportStateIdFunc(resourceName, attrName string) resource.ImportStateId
 		return rs.Primary.Attributes[attrName], nil
 	}
 }
+
+// RegionImportStateIdFunc is a resource.ImportStateIdFunc that returns the resource's ID with its Region appended,
+// e.g. `vpc-0123456789abcdef0@eu-west-1`, for resources that support per-resource Region override.
+func RegionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.ID + conns.ImportIDRegionSeparator + rs.Primary.Attributes[names.AttrRegion], nil
+	}
+}
+
+// RegionContext returns a Context in which the provider makes AWS API calls in the resource's Region,
+// for resources that support per-resource Region override.
+func RegionContext(ctx context.Context, rs *terraform.ResourceState) context.Context {
+	if region := rs.Primary.Attributes[names.AttrRegion]; region != "" {
+		return conns.NewRegionContext(ctx, region)
+	}
+
+	return ctx
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+var _ statecheck.StateCheck = &expectIdenticalStateCheck{}
+
+type expectIdenticalStateCheck struct {
+	base              Base
+	ignoredAttributes []string
+	recorded          bool
+	attributeValues   map[string]any
+}
+
+func (e *expectIdenticalStateCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	attributeValues := maps.Clone(resource.AttributeValues)
+	for _, v := range e.ignoredAttributes {
+		delete(attributeValues, v)
+	}
+
+	if !e.recorded {
+		e.recorded = true
+		e.attributeValues = attributeValues
+
+		return
+	}
+
+	if diff := cmp.Diff(e.attributeValues, attributeValues); diff != "" {
+		response.Error = fmt.Errorf("%s - state differs from recorded state (-recorded, +got):\n%s", e.base.ResourceAddress(), diff)
+
+		return
+	}
+}
+
+// ExpectIdenticalState returns a state check that records a resource's attribute values in the first test step that runs it
+// and checks that the resource's attribute values are identical in each later test step that runs it.
+// Use the same state check in each test step, e.g. to check that a resource migrated from the Plugin SDK v2
+// to the Plugin Framework produces the same state.
+func ExpectIdenticalState(resourceAddress string, ignoredAttributes ...string) statecheck.StateCheck {
+	return &expectIdenticalStateCheck{
+		base:              NewBase(resourceAddress),
+		ignoredAttributes: ignoredAttributes,
+	}
+}
@@ -7,30 +7,26 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
-	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
@@ -96,6 +92,38 @@ func isVCREnabled() bool {
 	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
 }
 
+// isVCRReplaying returns whether VCR is enabled and replaying recorded interactions.
+func isVCRReplaying() bool {
+	if !isVCREnabled() {
+		return false
+	}
+
+	mode, err := vcrMode()
+
+	return err == nil && mode == recorder.ModeReplayOnly
+}
+
+// preCheckVCRReplaying configures the "main" provider instance for replaying recorded interactions without AWS credentials.
+// Requests are signed with placeholder credentials, which recorded interactions are matched without.
+// The provider instance's account ID is not known, so checks that use it can't be replayed.
+func preCheckVCRReplaying(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+		os.Setenv(envvar.AccessKeyId, "VCRREPLAYING")
+		os.Setenv(envvar.SecretAccessKey, "vcr-replaying")
+	}
+	os.Setenv(envvar.DefaultRegion, Region())
+
+	diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(map[string]any{
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+	}))
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+}
+
 func vcrMode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case "RECORDING":
@@ -165,96 +193,12 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		r, err := newVCRRecorder(path, vcrMode, httpClient.Transport)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
-
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
@@ -290,6 +234,33 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// newVCRRecorder returns a VCR recorder for the specified cassette.
+func newVCRRecorder(path string, mode recorder.Mode, realTransport http.RoundTripper) (*recorder.Recorder, error) {
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          mode,
+		RealTransport: realTransport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	redactedValues := newVCRRedactedValues()
+
+	// Remove sensitive HTTP headers and values from the saved cassette.
+	// Redacting before saving rather than after capture means that responses are returned unredacted while recording.
+	r.AddHook(vcrRedactInteraction, recorder.BeforeSaveHook)
+
+	// Restore sensitive values sent in requests to recorded responses.
+	r.AddHook(redactedValues.restore, recorder.BeforeResponseReplayHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(vcrRequestMatcher(redactedValues))
+
+	return r, nil
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	vcrRedacted           = "REDACTED"
+	vcrTimestampValue     = "<timestamp>"
+	vcrUUIDValue          = "<uuid>"
+	vcrXMLFieldSeparator  = "\x00"
+	vcrQueryFieldSelector = "."
+)
+
+var (
+	// vcrIgnoredFields are request fields whose values differ between recording and replaying,
+	// e.g. idempotency tokens generated by the AWS SDKs and pagination tokens.
+	// Names are compared case-insensitively.
+	vcrIgnoredFields = vcrFieldSet(
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"ContinuationToken",
+		"IdempotencyToken",
+		"Marker",
+		"NextMarker",
+		"NextToken",
+		"PaginationToken",
+		"StartingToken",
+	)
+
+	// vcrSensitiveFields are fields whose values are redacted from recorded requests and responses.
+	// Names are compared case-insensitively.
+	vcrSensitiveFields = vcrFieldSet(
+		"AuthToken",
+		"MasterUserPassword",
+		"NewPassword",
+		"OldPassword",
+		"Password",
+		"PrivateKey",
+		"SecretAccessKey",
+		"SecretBinary",
+		"SecretString",
+		"SessionToken",
+	)
+
+	// vcrSensitiveHeaders are HTTP headers removed from recorded requests and responses.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"Set-Cookie",
+		"X-Amz-Security-Token",
+	}
+
+	// vcrSignatureQueryParameters are SigV4 query string parameters, e.g. in presigned URLs.
+	vcrSignatureQueryParameters = []string{
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// vcrTimestampWords are the words that end the names of fields holding timestamps, e.g. `StartTime` or `CreationDate`.
+	// Names are compared case-insensitively.
+	vcrTimestampWords = vcrFieldSet(
+		"Date",
+		"Time",
+		"Timestamp",
+	)
+
+	vcrUUIDRegexp = regexache.MustCompile(`^[[:xdigit:]]{8}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{12}$`)
+)
+
+func vcrFieldSet(names ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		m[strings.ToLower(name)] = struct{}{}
+	}
+	return m
+}
+
+func vcrIsIgnoredField(name string) bool {
+	_, ok := vcrIgnoredFields[strings.ToLower(name)]
+	return ok
+}
+
+func vcrIsSensitiveField(name string) bool {
+	_, ok := vcrSensitiveFields[strings.ToLower(name)]
+	return ok
+}
+
+// vcrIsTimestampField returns whether the named field holds a timestamp, e.g. `StartTime` or `CreationDate`.
+// The whole of the name's last word is matched so that names such as `Runtime` or `LastUpdate` are not.
+func vcrIsTimestampField(name string) bool {
+	_, ok := vcrTimestampWords[strings.ToLower(vcrLastWord(name))]
+	return ok
+}
+
+// vcrLastWord returns the last word of a camel case or snake case name,
+// e.g. `Time` for `StartTime`, `time` for `start_time` and `ARN` for `RoleARN`.
+func vcrLastWord(name string) string {
+	name = name[strings.LastIndex(name, "_")+1:]
+
+	i := strings.LastIndexFunc(name, func(r rune) bool {
+		return !unicode.IsLower(r) && !unicode.IsDigit(r)
+	})
+	if i < 0 {
+		return name
+	}
+
+	// The name ends with an upper case word.
+	if i == len(name)-1 {
+		return name[strings.LastIndexFunc(name, func(r rune) bool { return !unicode.IsUpper(r) })+1:]
+	}
+
+	return name[i:]
+}
+
+// vcrNormalizeValue returns a request field's value with any value that differs between recording and replaying
+// replaced by a placeholder.
+func vcrNormalizeValue(name, value string) string {
+	if vcrUUIDRegexp.MatchString(value) {
+		return vcrUUIDValue
+	}
+
+	if vcrIsTimestampField(name) {
+		return vcrTimestampValue
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05Z0700"} {
+		if _, err := time.Parse(layout, value); err == nil {
+			return vcrTimestampValue
+		}
+	}
+
+	return value
+}
+
+// vcrRequestMatcher returns a function returning whether an HTTP request matches a recorded request.
+// Method and URL must match. Request bodies are compared after normalization for the AWS protocol in use,
+// ignoring idempotency tokens, pagination tokens, timestamps, UUIDs and sensitive values.
+// The sensitive values in request bodies are collected so that they can be restored in recorded responses.
+func vcrRequestMatcher(redactedValues *vcrRedactedValues) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsMatch(r.URL.String(), i.URL) {
+			return false
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			return false
+		}
+		r.Body = io.NopCloser(&b)
+
+		contentType, body := r.Header.Get("Content-Type"), b.String()
+		redactedValues.collect(contentType, body)
+
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		return vcrBodiesMatch(contentType, body, i.Body)
+	}
+}
+
+// vcrURLsMatch returns whether two URLs match, ignoring SigV4 presigning query parameters
+// and normalizing query string values.
+func vcrURLsMatch(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	if ua.Scheme != ub.Scheme || ua.Host != ub.Host || ua.Path != ub.Path {
+		return false
+	}
+
+	return reflect.DeepEqual(vcrNormalizeQuery(ua.Query()), vcrNormalizeQuery(ub.Query()))
+}
+
+func vcrNormalizeQuery(values url.Values) url.Values {
+	for _, v := range vcrSignatureQueryParameters {
+		values.Del(v)
+	}
+
+	return vcrNormalizeForm(values)
+}
+
+// vcrNormalizeForm normalizes URL-encoded form values, e.g. an AWS Query protocol request body.
+// Field names such as `Tags.member.1.Key` are matched on their last element.
+func vcrNormalizeForm(values url.Values) url.Values {
+	output := make(url.Values, len(values))
+
+	for k, vs := range values {
+		name := k[strings.LastIndex(k, vcrQueryFieldSelector)+1:]
+
+		if vcrIsIgnoredField(name) || vcrIsSensitiveField(name) {
+			continue
+		}
+
+		for _, v := range vs {
+			output.Add(k, vcrNormalizeValue(name, v))
+		}
+	}
+
+	return output
+}
+
+// vcrBodiesMatch returns whether two request bodies of the specified content type match after normalization.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrBodiesMatch(contentType, a, b string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		na, err := vcrNormalizeJSON(a)
+		if err != nil {
+			return false
+		}
+		nb, err := vcrNormalizeJSON(b)
+		if err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(na, nb)
+
+	case "application/x-www-form-urlencoded":
+		va, err := url.ParseQuery(a)
+		if err != nil {
+			return false
+		}
+		vb, err := url.ParseQuery(b)
+		if err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(vcrNormalizeForm(va), vcrNormalizeForm(vb))
+
+	case "application/xml", "text/xml":
+		na, err := vcrNormalizeXML(a)
+		if err != nil {
+			return false
+		}
+		nb, err := vcrNormalizeXML(b)
+		if err != nil {
+			return false
+		}
+
+		return na == nb
+	}
+
+	return false
+}
+
+func vcrNormalizeJSON(s string) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return vcrNormalizeJSONValue("", v), nil
+}
+
+func vcrNormalizeJSONValue(name string, v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		output := make(map[string]any, len(v))
+		for k, v := range v {
+			if vcrIsIgnoredField(k) || vcrIsSensitiveField(k) {
+				continue
+			}
+			output[k] = vcrNormalizeJSONValue(k, v)
+		}
+		return output
+	case []any:
+		output := make([]any, len(v))
+		for i, v := range v {
+			output[i] = vcrNormalizeJSONValue(name, v)
+		}
+		return output
+	case string:
+		return vcrNormalizeValue(name, v)
+	case float64:
+		// Timestamps are serialized as epoch seconds in the AWS JSON protocols.
+		if vcrIsTimestampField(name) {
+			return vcrTimestampValue
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// vcrNormalizeXML returns a canonical form of an XML document, ignoring namespaces, attributes and whitespace.
+func vcrNormalizeXML(s string) (string, error) {
+	var sb strings.Builder
+	var names []string
+	skip := 0
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := token.Name.Local
+			names = append(names, name)
+			if skip > 0 || vcrIsIgnoredField(name) || vcrIsSensitiveField(name) {
+				skip++
+				continue
+			}
+			fmt.Fprintf(&sb, "<%s>", name)
+		case xml.EndElement:
+			names = names[:len(names)-1]
+			if skip > 0 {
+				skip--
+				continue
+			}
+			fmt.Fprintf(&sb, "</%s>", token.Name.Local)
+		case xml.CharData:
+			if skip > 0 || len(names) == 0 {
+				continue
+			}
+			if v := strings.TrimSpace(string(token)); v != "" {
+				sb.WriteString(vcrNormalizeValue(names[len(names)-1], v))
+				sb.WriteString(vcrXMLFieldSeparator)
+			}
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// vcrRedactInteraction removes sensitive values from a recorded interaction.
+// The values of sensitive fields are replaced by placeholders derived from the values,
+// so that a value sent in a request and returned in a response is replaced by the same placeholder.
+func vcrRedactInteraction(i *cassette.Interaction) error {
+	for _, v := range vcrSensitiveHeaders {
+		delete(i.Request.Headers, v)
+		delete(i.Response.Headers, v)
+	}
+
+	if u, err := url.Parse(i.Request.URL); err == nil {
+		query := u.Query()
+		redacted := false
+		for _, v := range vcrSignatureQueryParameters {
+			if query.Has(v) {
+				query.Set(v, vcrRedacted)
+				redacted = true
+			}
+		}
+		if redacted {
+			u.RawQuery = query.Encode()
+			i.Request.URL = u.String()
+		}
+	}
+
+	body, err := vcrReplaceSensitiveValues(i.Request.Headers.Get("Content-Type"), i.Request.Body, vcrRedactedValue)
+	if err != nil {
+		return fmt.Errorf("redacting request body: %w", err)
+	}
+	i.Request.Body = body
+
+	body, err = vcrReplaceSensitiveValues(i.Response.Headers.Get("Content-Type"), i.Response.Body, vcrRedactedValue)
+	if err != nil {
+		return fmt.Errorf("redacting response body: %w", err)
+	}
+	vcrSetResponseBody(i, body)
+
+	return nil
+}
+
+// vcrRedactedValue returns the placeholder for a sensitive value.
+func vcrRedactedValue(v string) string {
+	sum := sha256.Sum256([]byte(v))
+
+	return vcrRedacted + "-" + hex.EncodeToString(sum[:8])
+}
+
+func vcrSetResponseBody(i *cassette.Interaction, body string) {
+	if body != i.Response.Body {
+		i.Response.Body = body
+		i.Response.ContentLength = int64(len(body))
+		i.Response.Headers.Del("Content-Length")
+	}
+}
+
+// vcrRedactedValues maps the placeholders of sensitive values to the values sent in requests while replaying.
+// Recorded responses are returned with the placeholders of the values replaced,
+// e.g. a secret's value is returned as configured rather than as its placeholder.
+// Sensitive values which are only ever returned in responses, e.g. generated passwords, remain redacted.
+type vcrRedactedValues struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newVCRRedactedValues() *vcrRedactedValues {
+	return &vcrRedactedValues{
+		values: make(map[string]string),
+	}
+}
+
+// collect records the sensitive values in a request body.
+func (r *vcrRedactedValues) collect(contentType, body string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	vcrReplaceSensitiveValues(contentType, body, func(v string) string { //nolint:errcheck // Only the values are of interest
+		r.values[vcrRedactedValue(v)] = v
+		return v
+	})
+}
+
+// restore replaces the placeholders of known sensitive values in a recorded response.
+// It is a go-vcr BeforeResponseReplayHook.
+func (r *vcrRedactedValues) restore(i *cassette.Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.values) == 0 {
+		return nil
+	}
+
+	body, err := vcrReplaceSensitiveValues(i.Response.Headers.Get("Content-Type"), i.Response.Body, func(v string) string {
+		if value, ok := r.values[v]; ok {
+			return value
+		}
+		return v
+	})
+	if err != nil {
+		return fmt.Errorf("restoring response body: %w", err)
+	}
+	vcrSetResponseBody(i, body)
+
+	return nil
+}
+
+var vcrSensitiveXMLElementRegexps = func() []*regexp.Regexp {
+	var output []*regexp.Regexp
+	for name := range vcrSensitiveFields {
+		output = append(output, regexache.MustCompile(`(?i)(<`+name+`>)([^<]*)(</`+name+`>)`))
+	}
+	return output
+}()
+
+// vcrReplaceSensitiveValues returns a request or response body with the values of sensitive fields replaced by the result of calling f.
+func vcrReplaceSensitiveValues(contentType, body string, f func(string) string) (string, error) {
+	if body == "" {
+		return body, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// Unknown content.
+		return body, nil
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			// Not JSON, e.g. an HTML error page.
+			return body, nil
+		}
+
+		if !vcrReplaceJSONValues(v, f) {
+			return body, nil
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return body, nil
+		}
+
+		replaced := false
+		for k, vs := range values {
+			if vcrIsSensitiveField(k[strings.LastIndex(k, vcrQueryFieldSelector)+1:]) {
+				for i, v := range vs {
+					vs[i] = f(v)
+				}
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			return body, nil
+		}
+
+		return values.Encode(), nil
+
+	case "application/xml", "text/xml":
+		for _, re := range vcrSensitiveXMLElementRegexps {
+			body = re.ReplaceAllStringFunc(body, func(s string) string {
+				m := re.FindStringSubmatch(s)
+				var sb strings.Builder
+				sb.WriteString(m[1])
+				xml.EscapeText(&sb, []byte(f(html.UnescapeString(m[2])))) //nolint:errcheck // strings.Builder doesn't return errors
+				sb.WriteString(m[3])
+				return sb.String()
+			})
+		}
+
+		return body, nil
+	}
+
+	return body, nil
+}
+
+// vcrReplaceJSONValues replaces the values of sensitive fields in place, returning whether any value was replaced.
+func vcrReplaceJSONValues(v any, f func(string) string) bool {
+	replaced := false
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if s, ok := e.(string); ok && vcrIsSensitiveField(k) {
+				v[k] = f(s)
+				replaced = true
+				continue
+			}
+			replaced = vcrReplaceJSONValues(e, f) || replaced
+		}
+	case []any:
+		for _, e := range v {
+			replaced = vcrReplaceJSONValues(e, f) || replaced
+		}
+	}
+
+	return replaced
+}
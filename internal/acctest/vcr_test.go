@@ -4,9 +4,18 @@
 package acctest_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +61,282 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRURLsMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		A, B     string
+		Expected bool
+	}{
+		{
+			Name:     "identical",
+			A:        "https://sqs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			B:        "https://sqs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			Expected: true,
+		},
+		{
+			Name:     "query reordered",
+			A:        "https://example.com/path?a=1&b=2",
+			B:        "https://example.com/path?b=2&a=1",
+			Expected: true,
+		},
+		{
+			Name:     "pagination token",
+			A:        "https://example.com/path?maxResults=10&nextToken=abc",
+			B:        "https://example.com/path?maxResults=10&nextToken=def",
+			Expected: true,
+		},
+		{
+			Name:     "presigned",
+			A:        "https://example.com/key?X-Amz-Date=20240101T000000Z&X-Amz-Signature=abc",
+			B:        "https://example.com/key?X-Amz-Date=20240102T000000Z&X-Amz-Signature=def",
+			Expected: true,
+		},
+		{
+			Name: "different path",
+			A:    "https://example.com/a",
+			B:    "https://example.com/b",
+		},
+		{
+			Name: "different query",
+			A:    "https://example.com/path?a=1",
+			B:    "https://example.com/path?a=2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.VCRURLsMatch(testCase.A, testCase.B), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ContentType string
+		A, B        string
+		Expected    bool
+	}{
+		{
+			Name:        "JSON reordered",
+			ContentType: "application/x-amz-json-1.1",
+			A:           `{"Name":"test","Value":1}`,
+			B:           `{"Value":1,"Name":"test"}`,
+			Expected:    true,
+		},
+		{
+			Name:        "JSON client token",
+			ContentType: "application/x-amz-json-1.0",
+			A:           `{"Name":"test","ClientToken":"e3b0c442-98fc-1c14-9afb-f4c8996fb924"}`,
+			B:           `{"Name":"test","ClientToken":"a1b2c3d4-98fc-1c14-9afb-f4c8996fb924"}`,
+			Expected:    true,
+		},
+		{
+			Name:        "JSON timestamps",
+			ContentType: "application/x-amz-json-1.1",
+			A:           `{"StartTime":1704067200,"Filter":{"After":"2024-01-01T00:00:00Z"}}`,
+			B:           `{"StartTime":1704153600,"Filter":{"After":"2024-01-02T00:00:00Z"}}`,
+			Expected:    true,
+		},
+		{
+			Name:        "JSON not timestamps",
+			ContentType: "application/x-amz-json-1.1",
+			A:           `{"Runtime":"python3.12","MaxLifetime":3600,"LastUpdate":"a"}`,
+			B:           `{"Runtime":"python3.13","MaxLifetime":7200,"LastUpdate":"b"}`,
+		},
+		{
+			Name:        "JSON different",
+			ContentType: "application/json",
+			A:           `{"Name":"test1"}`,
+			B:           `{"Name":"test2"}`,
+		},
+		{
+			Name:        "query pagination token",
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			A:           "Action=DescribeVpcs&Version=2016-11-15&NextToken=abc",
+			B:           "NextToken=def&Action=DescribeVpcs&Version=2016-11-15",
+			Expected:    true,
+		},
+		{
+			Name:        "query different",
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			A:           "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-1",
+			B:           "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-2",
+		},
+		{
+			Name:        "XML caller reference",
+			ContentType: "application/xml",
+			A:           `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.com</Name><CallerReference>1</CallerReference></CreateHostedZoneRequest>`,
+			B: `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Name>example.com</Name>
+  <CallerReference>2</CallerReference>
+</CreateHostedZoneRequest>`,
+			Expected: true,
+		},
+		{
+			Name:        "XML different",
+			ContentType: "application/xml",
+			A:           `<Request><Name>example.com</Name></Request>`,
+			B:           `<Request><Name>example.org</Name></Request>`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.VCRBodiesMatch(testCase.ContentType, testCase.A, testCase.B), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRRedactInteraction(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body: "Action=CreateLoginProfile&Password=secret1&UserName=test",
+			Headers: http.Header{
+				"Authorization": []string{"AWS4-HMAC-SHA256 Credential=..."},
+				"Content-Type":  []string{"application/x-www-form-urlencoded; charset=utf-8"},
+			},
+			URL: "https://example.com/key?X-Amz-Signature=abc",
+		},
+		Response: cassette.Response{
+			Body: `{"Credentials":{"AccessKeyId":"ASIA","SecretAccessKey":"secret2","SessionToken":"secret3"}}`,
+			Headers: http.Header{
+				"Content-Type": []string{"application/x-amz-json-1.1"},
+			},
+		},
+	}
+
+	if err := acctest.VCRRedactInteraction(i); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := i.Request.Headers["Authorization"]; ok {
+		t.Error("Authorization header not removed")
+	}
+
+	for _, v := range []string{i.Request.Body, i.Request.URL, i.Response.Body} {
+		for _, secret := range []string{"secret1", "secret2", "secret3", "abc"} {
+			if strings.Contains(v, secret) {
+				t.Errorf("%q contains %q", v, secret)
+			}
+		}
+	}
+
+	if !strings.Contains(i.Response.Body, `"AccessKeyId":"ASIA"`) {
+		t.Errorf("unexpected response body: %s", i.Response.Body)
+	}
+
+	if !strings.Contains(i.Request.Body, "Password=REDACTED-") {
+		t.Errorf("unexpected request body: %s", i.Request.Body)
+	}
+}
+
+func TestVCRReplay(t *testing.T) {
+	t.Parallel()
+
+	const (
+		secret    = `s3cr3t&<"value">`
+		versionID = "00000000-0000-0000-0000-000000000001"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input struct {
+			Name         string
+			SecretString string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		json.NewEncoder(w).Encode(map[string]string{ //nolint:errcheck // Test server
+			"Name":         input.Name,
+			"SecretString": input.SecretString,
+			"VersionId":    versionID,
+		})
+	}))
+
+	do := func(t *testing.T, client *http.Client) map[string]string {
+		t.Helper()
+
+		request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(fmt.Sprintf(`{"Name":"test","SecretString":%q}`, secret)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+
+		var output map[string]string
+		if err := json.NewDecoder(response.Body).Decode(&output); err != nil {
+			t.Fatal(err)
+		}
+
+		return output
+	}
+
+	path := filepath.Join(t.TempDir(), "TestVCRReplay")
+
+	// Record.
+	r, err := acctest.NewVCRRecorder(path, recorder.ModeRecordOnce, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := do(t, r.GetDefaultClient())["SecretString"], secret; got != want {
+		t.Errorf("RECORDING SecretString = %q, want %q", got, want)
+	}
+
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path + ".yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "s3cr3t") {
+		t.Errorf("cassette contains secret:\n%s", b)
+	}
+
+	// Replay without the server.
+	server.Close()
+
+	r, err = acctest.NewVCRRecorder(path, recorder.ModeReplayOnly, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := do(t, r.GetDefaultClient())
+
+	if got, want := output["SecretString"], secret; got != want {
+		t.Errorf("REPLAYING SecretString = %q, want %q", got, want)
+	}
+	if got, want := output["VersionId"], versionID; got != want {
+		t.Errorf("REPLAYING VersionId = %q, want %q", got, want)
+	}
+
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apitrace records the AWS API calls made by the provider, for debugging slow applies.
+// Recording is opt-in: calls are written as JSON lines to the file named by the TF_AWS_API_TRACE_FILE
+// environment variable and/or exported as OpenTelemetry spans to the OTLP/HTTP collector at the
+// URL in the TF_AWS_API_TRACE_OTLP_ENDPOINT environment variable.
+package apitrace
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// FileEnvVar names the file to which API calls are written as JSON lines.
+	FileEnvVar = "TF_AWS_API_TRACE_FILE"
+	// OTLPEndpointEnvVar is the base URL, e.g. "http://localhost:4318", of an OpenTelemetry collector's OTLP/HTTP receiver.
+	OTLPEndpointEnvVar = "TF_AWS_API_TRACE_OTLP_ENDPOINT"
+)
+
+// Call is a record of a single AWS API call, including any retries.
+type Call struct {
+	Attempts     int           `json:"attempts"`
+	Duration     time.Duration `json:"-"`
+	DurationMS   float64       `json:"duration_ms"`
+	ErrorCode    string        `json:"error_code,omitempty"`
+	Operation    string        `json:"operation"`
+	Region       string        `json:"region,omitempty"`
+	RequestID    string        `json:"request_id,omitempty"`
+	ResourceName string        `json:"resource_name,omitempty"` // Friendly resource name, e.g. "Subnet".
+	Service      string        `json:"service"`                 // AWS SDK service ID, e.g. "EC2".
+	Start        time.Time     `json:"start"`
+	TypeName     string        `json:"resource_type,omitempty"` // Terraform type name, e.g. "aws_subnet".
+}
+
+type sink interface {
+	write(Call) error
+	close(*Summary) error
+}
+
+const (
+	recorderBufferSize = 1024
+)
+
+// Recorder records AWS API calls.
+// Calls are buffered and written to the recorder's sinks by a single goroutine, so that recording a call
+// doesn't block on file or network I/O.
+type Recorder struct {
+	calls   chan Call
+	closed  bool
+	done    chan struct{}
+	lock    sync.RWMutex // Guards closed and sends on calls.
+	sinks   []sink
+	sumLock sync.Mutex // Guards summary.
+	summary *Summary
+}
+
+func newRecorder(sinks ...sink) *Recorder {
+	r := &Recorder{
+		calls:   make(chan Call, recorderBufferSize),
+		done:    make(chan struct{}),
+		sinks:   sinks,
+		summary: NewSummary(),
+	}
+
+	go r.run()
+
+	return r
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+
+	for call := range r.calls {
+		for _, s := range r.sinks {
+			if err := s.write(call); err != nil {
+				log.Printf("[WARN] Recording AWS API call: %s", err)
+			}
+		}
+	}
+}
+
+// Record records an API call.
+func (r *Recorder) Record(_ context.Context, call Call) {
+	call.DurationMS = float64(call.Duration) / float64(time.Millisecond)
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if r.closed {
+		return
+	}
+
+	r.sumLock.Lock()
+	r.summary.Add(call)
+	r.sumLock.Unlock()
+
+	r.calls <- call
+}
+
+// Summary returns a summary of the API calls recorded so far.
+func (r *Recorder) Summary() *Summary {
+	r.sumLock.Lock()
+	defer r.sumLock.Unlock()
+
+	return r.summary.clone()
+}
+
+// Close writes any buffered API calls and the summary of the recorded API calls, and releases any resources held by the recorder.
+// Calls recorded after Close are discarded.
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	if r.closed {
+		r.lock.Unlock()
+		return nil
+	}
+	r.closed = true
+	close(r.calls)
+	r.lock.Unlock()
+
+	<-r.done
+
+	summary := r.Summary()
+
+	var errs []error
+	for _, s := range r.sinks {
+		errs = append(errs, s.close(summary))
+	}
+
+	return errors.Join(errs...)
+}
+
+var (
+	recorder     *Recorder
+	recorderErr  error
+	recorderOnce sync.Once
+)
+
+// FromEnv returns the process-wide recorder configured by environment variables,
+// so that all provider configurations record to the same destinations.
+// It returns nil if recording is not enabled.
+func FromEnv() (*Recorder, error) {
+	recorderOnce.Do(func() {
+		var sinks []sink
+
+		if v := os.Getenv(FileEnvVar); v != "" {
+			s, err := newJSONLinesSink(v)
+			if err != nil {
+				recorderErr = err
+				return
+			}
+			sinks = append(sinks, s)
+		}
+
+		if v := os.Getenv(OTLPEndpointEnvVar); v != "" {
+			sinks = append(sinks, newOTLPSink(v))
+		}
+
+		if len(sinks) > 0 {
+			recorder = newRecorder(sinks...)
+		}
+	})
+
+	return recorder, recorderErr
+}
+
+// Shutdown closes the process-wide recorder, if any, and logs the summary of the recorded API calls.
+// It should be called once the provider has stopped serving requests.
+func Shutdown() {
+	// Synchronize with any concurrent FromEnv, without creating a recorder if none exists.
+	recorderOnce.Do(func() {})
+
+	if recorder == nil {
+		return
+	}
+
+	log.Printf("[INFO] AWS API usage:\n%s", recorder.Summary())
+
+	if err := recorder.Close(); err != nil {
+		log.Printf("[WARN] Closing AWS API call recorder: %s", err)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apitrace
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testCalls = []Call{
+	{
+		Attempts:     1,
+		Duration:     100 * time.Millisecond,
+		Operation:    "DescribeVpcs",
+		Region:       "us-west-2", //lintignore:AWSAT003
+		Service:      "EC2",
+		ResourceName: "VPC",
+		TypeName:     "aws_vpc",
+	},
+	{
+		Attempts:     3,
+		Duration:     2 * time.Second,
+		ErrorCode:    "Throttling",
+		Operation:    "ChangeResourceRecordSets",
+		Service:      "Route 53",
+		ResourceName: "Record",
+		TypeName:     "aws_route53_record",
+	},
+	{
+		Attempts:     1,
+		Duration:     300 * time.Millisecond,
+		Operation:    "DescribeVpcs",
+		Service:      "EC2",
+		ResourceName: "Subnet",
+		TypeName:     "aws_subnet",
+	},
+	{
+		Attempts:  1,
+		Duration:  50 * time.Millisecond,
+		Operation: "GetCallerIdentity",
+		Service:   "STS",
+	},
+}
+
+func TestSummary(t *testing.T) {
+	t.Parallel()
+
+	summary := NewSummary()
+	for _, call := range testCalls {
+		summary.Add(call)
+	}
+
+	var got []string
+	for _, v := range summary.Operations() {
+		got = append(got, v.Name)
+	}
+	want := []string{"Route 53.ChangeResourceRecordSets", "EC2.DescribeVpcs", "STS.GetCallerIdentity"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected operations diff (+wanted, -got): %s", diff)
+	}
+
+	got = nil
+	for _, v := range summary.ResourceTypes() {
+		got = append(got, v.Name)
+	}
+	want = []string{"aws_route53_record", "aws_subnet", "aws_vpc", "(provider)"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected resource types diff (+wanted, -got): %s", diff)
+	}
+
+	stats := summary.Operations()[1]
+	if got, want := stats.Calls, 2; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if got, want := stats.Duration, 400*time.Millisecond; got != want {
+		t.Errorf("Duration = %s, want %s", got, want)
+	}
+
+	stats = summary.Operations()[0]
+	if got, want := stats.Attempts, 3; got != want {
+		t.Errorf("Attempts = %d, want %d", got, want)
+	}
+	if got, want := stats.Errors, 1; got != want {
+		t.Errorf("Errors = %d, want %d", got, want)
+	}
+
+	if s := summary.String(); !strings.Contains(s, "RESOURCE TYPE") || !strings.Contains(s, "aws_route53_record") {
+		t.Errorf("unexpected summary:\n%s", s)
+	}
+}
+
+func TestRecorderJSONLines(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	s, err := newJSONLinesSink(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	recorder := newRecorder(s)
+
+	for _, call := range testCalls {
+		recorder.Record(ctx, call)
+	}
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer file.Close()
+
+	var types []string
+	var first map[string]any
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if first == nil {
+			first = line
+		}
+		types = append(types, line["type"].(string))
+	}
+
+	if diff := cmp.Diff(types, []string{"call", "call", "call", "call", "summary"}); diff != "" {
+		t.Errorf("unexpected lines diff (+wanted, -got): %s", diff)
+	}
+
+	want := map[string]any{
+		"type":          "call",
+		"attempts":      float64(1),
+		"duration_ms":   float64(100),
+		"operation":     "DescribeVpcs",
+		"region":        "us-west-2", //lintignore:AWSAT003
+		"resource_name": "VPC",
+		"resource_type": "aws_vpc",
+		"service":       "EC2",
+		"start":         "0001-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(first, want); diff != "" {
+		t.Errorf("unexpected call diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRecorderOTLP(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var spans atomic.Int32
+	var traceIDs sync.Map
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var request struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []otlpSpan `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		if err := json.Unmarshal(body, &request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		for _, v := range request.ResourceSpans {
+			for _, v := range v.ScopeSpans {
+				for _, span := range v.Spans {
+					if len(span.TraceID) != 32 || len(span.SpanID) != 16 || span.Kind != otlpSpanKindClient {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					spans.Add(1)
+					traceIDs.Store(span.TraceID, struct{}{})
+				}
+			}
+		}
+	}))
+	defer server.Close()
+
+	recorder := newRecorder(newOTLPSink(server.URL + "/"))
+
+	for range otlpBatchSize / len(testCalls) * 2 {
+		for _, call := range testCalls {
+			recorder.Record(ctx, call)
+		}
+	}
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := spans.Load(), int32(otlpBatchSize*2); got != want {
+		t.Errorf("%d spans exported, want %d", got, want)
+	}
+
+	var n int
+	traceIDs.Range(func(_, _ any) bool {
+		n++
+		return true
+	})
+	if n != 1 {
+		t.Errorf("spans exported in %d traces, want 1", n)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apitrace
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// jsonLinesSink writes each API call as a JSON line to a file, followed by a final summary line.
+type jsonLinesSink struct {
+	encoder *json.Encoder
+	file    *os.File
+}
+
+func newJSONLinesSink(name string) (*jsonLinesSink, error) {
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening AWS API trace file: %w", err)
+	}
+
+	return &jsonLinesSink{
+		encoder: json.NewEncoder(file),
+		file:    file,
+	}, nil
+}
+
+func (s *jsonLinesSink) write(call Call) error {
+	return s.encoder.Encode(struct {
+		Type string `json:"type"`
+		Call
+	}{
+		Type: "call",
+		Call: call,
+	})
+}
+
+func (s *jsonLinesSink) close(summary *Summary) error {
+	err := s.encoder.Encode(struct {
+		Type          string  `json:"type"`
+		Operations    []Stats `json:"operations"`
+		ResourceTypes []Stats `json:"resource_types"`
+	}{
+		Type:          "summary",
+		Operations:    summary.Operations(),
+		ResourceTypes: summary.ResourceTypes(),
+	})
+
+	if closeErr := s.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// otlpSink exports each API call as an OpenTelemetry span to an OTLP/HTTP collector using the JSON protobuf encoding.
+// All spans exported by a sink belong to a single trace, so that the API calls made by a provider process are grouped together.
+// See https://opentelemetry.io/docs/specs/otlp/#otlphttp.
+type otlpSink struct {
+	batch      []Call
+	httpClient *http.Client
+	traceID    string
+	url        string
+	wg         sync.WaitGroup
+}
+
+const (
+	otlpBatchSize = 100
+)
+
+func newOTLPSink(endpoint string) *otlpSink {
+	return &otlpSink{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		traceID:    randomHex(16),
+		url:        strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+	}
+}
+
+func (s *otlpSink) write(call Call) error {
+	s.batch = append(s.batch, call)
+
+	if len(s.batch) >= otlpBatchSize {
+		batch := s.batch
+		s.batch = nil
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			if err := s.export(context.Background(), batch); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+func (s *otlpSink) close(*Summary) error {
+	s.wg.Wait()
+
+	if len(s.batch) == 0 {
+		return nil
+	}
+
+	batch := s.batch
+	s.batch = nil
+
+	return s.export(context.Background(), batch)
+}
+
+func (s *otlpSink) export(ctx context.Context, calls []Call) error {
+	body, err := json.Marshal(otlpTraces(s.traceID, calls))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("exporting AWS API trace spans: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("exporting AWS API trace spans: %s", response.Status)
+	}
+
+	return nil
+}
+
+// OTLP JSON encoding of ExportTraceServiceRequest.
+// See https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto.
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	IntValue    string `json:"intValue,omitempty"` // 64-bit integers are encoded as strings.
+	StringValue string `json:"stringValue,omitempty"`
+}
+
+type otlpSpan struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Kind              int            `json:"kind"`
+	Name              string         `json:"name"`
+	SpanID            string         `json:"spanId"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	Status            otlpStatus     `json:"status"`
+	TraceID           string         `json:"traceId"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindClient  = 3
+	otlpStatusCodeOK    = 1
+	otlpStatusCodeError = 2
+)
+
+func otlpTraces(traceID string, calls []Call) any {
+	spans := make([]otlpSpan, 0, len(calls))
+
+	for _, call := range calls {
+		attributes := []otlpKeyValue{
+			otlpString("rpc.system", "aws-api"),
+			otlpString("rpc.service", call.Service),
+			otlpString("rpc.method", call.Operation),
+			otlpInt("aws.attempts", call.Attempts),
+		}
+		for _, v := range []otlpKeyValue{
+			otlpString("aws.region", call.Region),
+			otlpString("aws.request_id", call.RequestID),
+			otlpString("aws.error_code", call.ErrorCode),
+			otlpString("terraform.resource_type", call.TypeName),
+			otlpString("terraform.resource_name", call.ResourceName),
+		} {
+			if v.Value.StringValue != "" {
+				attributes = append(attributes, v)
+			}
+		}
+
+		status := otlpStatus{Code: otlpStatusCodeOK}
+		if call.ErrorCode != "" {
+			status = otlpStatus{Code: otlpStatusCodeError, Message: call.ErrorCode}
+		}
+
+		spans = append(spans, otlpSpan{
+			Attributes:        attributes,
+			EndTimeUnixNano:   strconv.FormatInt(call.Start.Add(call.Duration).UnixNano(), 10),
+			Kind:              otlpSpanKindClient,
+			Name:              call.Service + "." + call.Operation,
+			SpanID:            randomHex(8),
+			StartTimeUnixNano: strconv.FormatInt(call.Start.UnixNano(), 10),
+			Status:            status,
+			TraceID:           traceID,
+		})
+	}
+
+	type scopeSpans struct {
+		Scope map[string]string `json:"scope"`
+		Spans []otlpSpan        `json:"spans"`
+	}
+	type resourceSpans struct {
+		Resource   map[string][]otlpKeyValue `json:"resource"`
+		ScopeSpans []scopeSpans              `json:"scopeSpans"`
+	}
+
+	return map[string][]resourceSpans{
+		"resourceSpans": {
+			{
+				Resource: map[string][]otlpKeyValue{
+					"attributes": {otlpString("service.name", "terraform-provider-aws")},
+				},
+				ScopeSpans: []scopeSpans{
+					{
+						Scope: map[string]string{"name": "github.com/hashicorp/terraform-provider-aws/internal/apitrace"},
+						Spans: spans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpString(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
+
+func otlpInt(key string, value int) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: strconv.Itoa(value)}}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apitrace
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Stats are the aggregated statistics of a set of API calls.
+type Stats struct {
+	Name     string        `json:"name"`
+	Calls    int           `json:"calls"`
+	Attempts int           `json:"attempts"`
+	Errors   int           `json:"errors"`
+	Duration time.Duration `json:"-"`
+	// DurationMS is the total duration of the calls in milliseconds.
+	// As calls are made concurrently, the total can exceed the wall time of an apply.
+	DurationMS float64 `json:"duration_ms"`
+}
+
+func (s *Stats) add(call Call) {
+	s.Calls++
+	s.Attempts += call.Attempts
+	if call.ErrorCode != "" {
+		s.Errors++
+	}
+	s.Duration += call.Duration
+	s.DurationMS = float64(s.Duration) / float64(time.Millisecond)
+}
+
+// Summary summarizes API calls by operation and by Terraform resource type.
+type Summary struct {
+	operations    map[string]*Stats
+	resourceTypes map[string]*Stats
+}
+
+// NewSummary returns an empty summary.
+func NewSummary() *Summary {
+	return &Summary{
+		operations:    make(map[string]*Stats),
+		resourceTypes: make(map[string]*Stats),
+	}
+}
+
+// Add adds an API call to the summary.
+func (s *Summary) Add(call Call) {
+	add := func(m map[string]*Stats, name string) {
+		v, ok := m[name]
+		if !ok {
+			v = &Stats{Name: name}
+			m[name] = v
+		}
+		v.add(call)
+	}
+
+	add(s.operations, call.Service+"."+call.Operation)
+
+	// API calls made outside of a resource, e.g. while configuring the provider, are grouped together.
+	typeName := call.TypeName
+	if typeName == "" {
+		typeName = "(provider)"
+	}
+	add(s.resourceTypes, typeName)
+}
+
+// Operations returns statistics per API operation, e.g. "EC2.DescribeVpcs", ordered by decreasing total duration.
+func (s *Summary) Operations() []Stats {
+	return sortedStats(s.operations)
+}
+
+// ResourceTypes returns statistics per Terraform resource type, ordered by decreasing total duration.
+func (s *Summary) ResourceTypes() []Stats {
+	return sortedStats(s.resourceTypes)
+}
+
+func (s *Summary) clone() *Summary {
+	cloneStats := func(m map[string]*Stats) map[string]*Stats {
+		v := make(map[string]*Stats, len(m))
+		for k, stats := range m {
+			stats := *stats
+			v[k] = &stats
+		}
+		return v
+	}
+
+	return &Summary{
+		operations:    cloneStats(s.operations),
+		resourceTypes: cloneStats(s.resourceTypes),
+	}
+}
+
+// String returns the summary as tables of the resource types and operations that dominate API call time.
+func (s *Summary) String() string {
+	const (
+		top = 20
+	)
+	var sb strings.Builder
+
+	for _, v := range []struct {
+		heading string
+		stats   []Stats
+	}{
+		{"RESOURCE TYPE", s.ResourceTypes()},
+		{"OPERATION", s.Operations()},
+	} {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+
+		tw := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+		fmt.Fprintf(tw, "%s\tCALLS\tATTEMPTS\tERRORS\tDURATION\n", v.heading)
+		for i, stats := range v.stats {
+			if i == top {
+				fmt.Fprintf(tw, "... %d more\t\t\t\t\n", len(v.stats)-top)
+				break
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\n", stats.Name, stats.Calls, stats.Attempts, stats.Errors, stats.Duration.Round(time.Millisecond))
+		}
+		tw.Flush()
+	}
+
+	return sb.String()
+}
+
+func sortedStats(m map[string]*Stats) []Stats {
+	stats := make([]Stats, 0, len(m))
+	for _, v := range m {
+		stats = append(stats, *v)
+	}
+
+	slices.SortFunc(stats, func(a, b Stats) int {
+		if n := cmp.Compare(b.Duration, a.Duration); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return stats
+}
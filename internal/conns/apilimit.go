@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APILimits contains client-side limits on API requests.
+// Zero values mean no limit.
+type APILimits struct {
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+}
+
+// IsEmpty returns whether there are no limits.
+func (l APILimits) IsEmpty() bool {
+	return l.MaxConcurrentRequests <= 0 && l.RequestsPerSecond <= 0
+}
+
+// apiLimiter enforces APILimits.
+// Each request attempt, including retries, acquires the limiter.
+type apiLimiter struct {
+	inFlight  atomic.Int32
+	interval  time.Duration // Minimum interval between requests.
+	lock      sync.Mutex
+	next      time.Time // Earliest time of the next request.
+	semaphore chan struct{}
+}
+
+func newAPILimiter(limits APILimits) *apiLimiter {
+	if limits.IsEmpty() {
+		return nil
+	}
+
+	l := &apiLimiter{}
+
+	if limits.MaxConcurrentRequests > 0 {
+		l.semaphore = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+
+	if limits.RequestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / limits.RequestsPerSecond)
+	}
+
+	return l
+}
+
+// acquire waits until a request is allowed by the limiter.
+// The returned function must be called once the request completes.
+func (l *apiLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		l.inFlight.Add(-1)
+		if l.semaphore != nil {
+			<-l.semaphore
+		}
+	}
+
+	if l.interval > 0 {
+		l.lock.Lock()
+		now := time.Now()
+		at := l.next
+		if at.Before(now) {
+			at = now
+		}
+		l.next = at.Add(l.interval)
+		l.lock.Unlock()
+
+		if d := at.Sub(now); d > 0 {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				// Release the reserved slot, unless a later request has since reserved the following one.
+				l.lock.Lock()
+				if l.next.Equal(at.Add(l.interval)) {
+					l.next = at
+				}
+				l.lock.Unlock()
+
+				if l.semaphore != nil {
+					<-l.semaphore
+				}
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	l.inFlight.Add(1)
+
+	return release, nil
+}
+
+// serviceAPILimiter enforces a service's API limits and the limits of the service's individual operations.
+type serviceAPILimiter struct {
+	operations map[string]*apiLimiter
+	service    *apiLimiter
+}
+
+func newServiceAPILimiter(settings ServiceSettings) *serviceAPILimiter {
+	l := &serviceAPILimiter{
+		operations: make(map[string]*apiLimiter),
+		service:    newAPILimiter(settings.Limits),
+	}
+
+	for operation, limits := range settings.OperationLimits {
+		if v := newAPILimiter(limits); v != nil {
+			l.operations[operation] = v
+		}
+	}
+
+	if l.service == nil && len(l.operations) == 0 {
+		return nil
+	}
+
+	return l
+}
+
+// awsConfig returns a copy of the specified AWS SDK for Go v2 configuration with the limiter's middleware added.
+func (l *serviceAPILimiter) awsConfig(servicePackageName string, cfg *aws.Config) *aws.Config {
+	v := cfg.Copy()
+	v.APIOptions = append(slices.Clone(v.APIOptions), l.addMiddleware(servicePackageName))
+
+	return &v
+}
+
+// addMiddleware adds the limiter's middleware to an AWS SDK for Go v2 API client's middleware stack.
+// The middleware runs after the retry middleware so that each attempt is limited, and before signing
+// so that requests are signed once they are allowed.
+func (l *serviceAPILimiter) addMiddleware(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		const (
+			signingMiddlewareID = "Signing"
+		)
+		mw := middleware.FinalizeMiddlewareFunc("TerraformAWSProviderAPILimiter", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			operation := middleware.GetOperationName(ctx)
+			start := time.Now()
+
+			// Acquire the operation's limiter first so that waiting for it doesn't hold capacity for the whole service.
+			var limiters []*apiLimiter
+			if v, ok := l.operations[operation]; ok {
+				limiters = append(limiters, v)
+			}
+			if l.service != nil {
+				limiters = append(limiters, l.service)
+			}
+
+			for _, limiter := range limiters {
+				release, err := limiter.acquire(ctx)
+				if err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for %s %s API limit: %w", servicePackageName, operation, err)
+				}
+				defer release()
+			}
+
+			if wait := time.Since(start); wait >= time.Millisecond {
+				fields := map[string]any{
+					"tf_aws.api_limit.operation": operation,
+					"tf_aws.api_limit.service":   servicePackageName,
+					"tf_aws.api_limit.wait":      wait.String(),
+				}
+				if l.service != nil {
+					fields["tf_aws.api_limit.in_flight"] = l.service.inFlight.Load()
+				}
+				tflog.Debug(ctx, "API request delayed by client-side limit", fields)
+			}
+
+			return next.HandleFinalize(ctx, in)
+		})
+
+		if _, ok := stack.Finalize.Get(signingMiddlewareID); ok {
+			return stack.Finalize.Insert(mw, signingMiddlewareID, middleware.Before)
+		}
+
+		return stack.Finalize.Add(mw, middleware.After)
+	}
+}
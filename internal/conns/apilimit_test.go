@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestAPILimiterMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const maxConcurrentRequests = 2
+	l := newAPILimiter(APILimits{MaxConcurrentRequests: maxConcurrentRequests})
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			release, err := l.acquire(ctx)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer release()
+
+			n := inFlight.Add(1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if got, want := maxInFlight.Load(), int32(maxConcurrentRequests); got > want {
+		t.Errorf("%d concurrent requests, want at most %d", got, want)
+	}
+}
+
+func TestAPILimiterRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newAPILimiter(APILimits{RequestsPerSecond: 50})
+
+	start := time.Now()
+	for range 5 {
+		release, err := l.acquire(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		release()
+	}
+
+	// The first request isn't delayed.
+	if got, want := time.Since(start), 4*20*time.Millisecond; got < want {
+		t.Errorf("5 requests took %s, want at least %s", got, want)
+	}
+}
+
+func TestAPILimiterContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newAPILimiter(APILimits{MaxConcurrentRequests: 1})
+
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestAPILimiterRequestsPerSecondContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newAPILimiter(APILimits{RequestsPerSecond: 1})
+
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	release()
+
+	next := l.next
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+
+	// The canceled request's slot is released.
+	if got, want := l.next, next; !got.Equal(want) {
+		t.Errorf("next request at %s, want %s", got, want)
+	}
+}
+
+func TestServiceAPILimiterMiddleware(t *testing.T) {
+	t.Parallel()
+
+	if l := newServiceAPILimiter(ServiceSettings{MaxRetries: 5}); l != nil {
+		t.Error("expected no limiter")
+	}
+
+	l := newServiceAPILimiter(ServiceSettings{
+		OperationLimits: map[string]APILimits{
+			"ChangeResourceRecordSets": {MaxConcurrentRequests: 1},
+		},
+	})
+	if l == nil {
+		t.Fatal("expected limiter")
+	}
+
+	stack := middleware.NewStack("test", nil)
+	for _, id := range []string{"Retry", "Signing"} {
+		if err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(id, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := l.addMiddleware("route53")(stack); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := stack.Finalize.List()
+	want := []string{"Retry", "TerraformAWSProviderAPILimiter", "Signing"}
+	if len(got) != len(want) {
+		t.Fatalf("Finalize middleware = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Finalize middleware = %v, want %v", got, want)
+			break
+		}
+	}
+}
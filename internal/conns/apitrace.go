@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/apitrace"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// addAPITraceMiddleware returns an AWS SDK for Go v2 API option that records each API call.
+// The middleware is the first to run so that recorded latencies include retries.
+func addAPITraceMiddleware(recorder *apitrace.Recorder) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAWSProviderAPITrace", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			recorder.Record(ctx, newAPITraceCall(ctx, start, metadata, err))
+
+			return out, metadata, err
+		}), middleware.Before)
+	}
+}
+
+func newAPITraceCall(ctx context.Context, start time.Time, metadata middleware.Metadata, err error) apitrace.Call {
+	call := apitrace.Call{
+		Duration:  time.Since(start),
+		Operation: awsmiddleware.GetOperationName(ctx),
+		Region:    awsmiddleware.GetRegion(ctx),
+		Service:   awsmiddleware.GetServiceID(ctx),
+		Start:     start,
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok {
+		call.Attempts = len(v.Results)
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		call.RequestID = v
+	}
+
+	if err != nil {
+		if apiErr, ok := errs.As[smithy.APIError](err); ok {
+			call.ErrorCode = apiErr.ErrorCode()
+		} else if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			call.ErrorCode = "Canceled"
+		} else {
+			call.ErrorCode = "Unknown"
+		}
+	}
+
+	if inContext, ok := FromContext(ctx); ok {
+		call.ResourceName = inContext.ResourceName
+		call.TypeName = inContext.TypeName
+	}
+
+	return call
+}
@@ -30,21 +30,26 @@ type AWSClient struct {
 	defaultTagsConfig *tftags.DefaultConfig
 	ignoreTagsConfig  *tftags.IgnoreConfig
 	Region            string
+	tagPolicyConfig   *tftags.PolicyConfig
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	allowedRegions                 []string // From provider configuration.
+	apiLimiters                    map[string]*serviceAPILimiter
+	awsConfig                      *aws.Config
+	clients                        map[string]any
+	conns                          map[string]any
+	endpoints                      map[string]string // From provider configuration.
+	httpClient                     *http.Client
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	partition                      endpoints.Partition
+	session                        *session_sdkv1.Session
+	s3ExpressClient                *s3.Client
+	s3UsePathStyle                 bool                       // From provider configuration.
+	s3USEast1RegionalEndpoint      string                     // From provider configuration.
+	serviceSettings                map[string]ServiceSettings // From provider configuration.
+	stsRegion                      string                     // From provider configuration.
+	tokenBucketRateLimiterCapacity int                        // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -55,7 +60,13 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// If called for a resource, the configuration is that which applies to the resource's type and service package.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(inContext.ServicePackageName, inContext.TypeName)
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -63,6 +74,11 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// TagPolicyConfig returns the provider's tag policy, including any rules from the AWS Organizations effective tag policy.
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -76,6 +92,33 @@ func (c *AWSClient) Endpoints(context.Context) map[string]string {
 	return maps.Clone(c.endpoints)
 }
 
+// EffectiveRegion returns the AWS Region in which API calls are made.
+// This is the provider's configured Region unless a resource has overridden it via its `region` argument.
+func (c *AWSClient) EffectiveRegion(ctx context.Context) string {
+	if v, ok := RegionFromContext(ctx); ok {
+		return v
+	}
+
+	return c.Region
+}
+
+// ValidateRegion returns an error if a resource's Region override is not a valid AWS Region in the configured AWS partition.
+func (c *AWSClient) ValidateRegion(ctx context.Context, region string) error {
+	if !regionRegexp.MatchString(region) {
+		return fmt.Errorf("region (%s) is not a valid AWS Region code", region)
+	}
+
+	if partition := names.PartitionForRegion(region); partition.ID() != c.Partition(ctx) {
+		return fmt.Errorf("region (%s) is in partition (%s), not the configured partition (%s)", region, partition.ID(), c.Partition(ctx))
+	}
+
+	if err := verifyRegionAllowed(region, c.allowedRegions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Partition returns the ID of the configured AWS partition.
 func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
@@ -93,7 +136,7 @@ func (c *AWSClient) RegionalARN(ctx context.Context, service, resource string) s
 	return arn.ARN{
 		Partition: c.Partition(ctx),
 		Service:   service,
-		Region:    c.Region,
+		Region:    c.EffectiveRegion(ctx),
 		AccountID: c.AccountID,
 		Resource:  resource,
 	}.String()
@@ -104,7 +147,7 @@ func (c *AWSClient) RegionalARNNoAccount(ctx context.Context, service, resource
 	return arn.ARN{
 		Partition: c.Partition(ctx),
 		Service:   service,
-		Region:    c.Region,
+		Region:    c.EffectiveRegion(ctx),
 		Resource:  resource,
 	}.String()
 }
@@ -113,7 +156,7 @@ func (c *AWSClient) RegionalARNNoAccount(ctx context.Context, service, resource
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.EffectiveRegion(ctx), c.DNSSuffix(ctx))
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -122,6 +165,17 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	// The cached client is for the provider's configured Region.
+	if c.EffectiveRegion(ctx) != c.Region {
+		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
+			return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -234,8 +288,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.EffectiveRegion(ctx)
 	if region == endpoints.UsEast1RegionID {
 		return "ec2.internal"
 	}
@@ -244,8 +298,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.EffectiveRegion(ctx)
 	if region == endpoints.UsEast1RegionID {
 		return "compute-1"
 	}
@@ -280,8 +334,21 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if v, ok := c.serviceSettings[servicePackageName]; ok {
+		awsConfig = v.awsConfig(awsConfig, c.tokenBucketRateLimiterCapacity)
+	}
+	if v, ok := c.apiLimiters[servicePackageName]; ok {
+		awsConfig = v.awsConfig(servicePackageName, awsConfig)
+	}
+	if v, ok := RegionFromContext(ctx); ok && v != awsConfig.Region {
+		cfg := awsConfig.Copy()
+		cfg.Region = v
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -301,19 +368,30 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// clientCacheKey returns the key under which the default API client for the specified service is cached.
+// Clients for a resource's overridden Region are cached separately from those for the provider's configured Region.
+func (c *AWSClient) clientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.EffectiveRegion(ctx); region != c.Region {
+		return servicePackageName + "/" + region
+	}
+
+	return servicePackageName
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -348,7 +426,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -99,6 +99,115 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientEffectiveRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		partition: standardPartition,
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name             string
+		Context          context.Context
+		ExpectedRegion   string
+		ExpectedCacheKey string
+		ExpectedHostname string
+	}{
+		{
+			Name:             "no override",
+			Context:          context.TODO(),
+			ExpectedRegion:   "us-west-2", //lintignore:AWSAT003
+			ExpectedCacheKey: "ec2",
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:             "empty override",
+			Context:          NewRegionContext(context.TODO(), ""),
+			ExpectedRegion:   "us-west-2", //lintignore:AWSAT003
+			ExpectedCacheKey: "ec2",
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:             "same Region override",
+			Context:          NewRegionContext(context.TODO(), "us-west-2"), //lintignore:AWSAT003
+			ExpectedRegion:   "us-west-2",                                   //lintignore:AWSAT003
+			ExpectedCacheKey: "ec2",
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:             "override",
+			Context:          NewRegionContext(context.TODO(), "eu-west-1"), //lintignore:AWSAT003
+			ExpectedRegion:   "eu-west-1",                                   //lintignore:AWSAT003
+			ExpectedCacheKey: "ec2/eu-west-1",                               //lintignore:AWSAT003
+			ExpectedHostname: "test.eu-west-1.amazonaws.com",                //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := client.EffectiveRegion(testCase.Context), testCase.ExpectedRegion; got != want {
+				t.Errorf("EffectiveRegion: got %s, expected %s", got, want)
+			}
+			if got, want := client.clientCacheKey(testCase.Context, "ec2"), testCase.ExpectedCacheKey; got != want {
+				t.Errorf("clientCacheKey: got %s, expected %s", got, want)
+			}
+			if got, want := client.RegionalHostname(testCase.Context, "test"), testCase.ExpectedHostname; got != want {
+				t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientValidateRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		allowedRegions: []string{"eu-west-1", "us-west-2"}, //lintignore:AWSAT003
+		partition:      standardPartition,
+		Region:         "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name        string
+		Region      string
+		ExpectError bool
+	}{
+		{
+			Name:   "same partition",
+			Region: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:        "not allowed",
+			Region:      "eu-central-1", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+		{
+			Name:        "other partition",
+			Region:      "cn-north-1", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid",
+			Region:      "Europe",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := client.ValidateRegion(ctx, testCase.Region)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -6,11 +6,14 @@ package conns
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -19,6 +22,7 @@ import (
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/apitrace"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -26,11 +30,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationIDs         []string
+	AllowedPartitions              []string
+	AllowedRegions                 []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CredentialsDiagnostics         bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -42,6 +54,7 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxResourceTimeout             time.Duration // Longest default operation timeout of the provider's resources.
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
@@ -50,6 +63,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceSettings                map[string]ServiceSettings // Keyed by service package name.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,6 +71,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -70,9 +85,6 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -163,6 +175,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if err := verifyRegionAllowed(c.Region, c.AllowedRegions); err != nil {
+		return nil, append(diags, errs.NewErrorDiagnostic("AWS Region not allowed", err.Error()))
+	}
+
+	if c.CredentialsDiagnostics && cfg.Credentials != nil {
+		cfg.Credentials = newRefreshingCredentialsProvider(cfg.Credentials)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -201,6 +221,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
 
+	// The partition is not returned if the account ID isn't requested.
+	allowedPartitionID := partitionID
+	if allowedPartitionID == "" {
+		allowedPartitionID = names.PartitionForRegion(c.Region).ID()
+	}
+	if err := verifyPartitionAllowed(allowedPartitionID, c.AllowedPartitions); err != nil {
+		return nil, append(diags, errs.NewErrorDiagnostic("AWS partition not allowed", err.Error()))
+	}
+
+	if c.CredentialsDiagnostics && cfg.Credentials != nil {
+		credentials, err := cfg.Credentials.Retrieve(ctx)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving AWS credentials: %s", err)
+		}
+
+		now := time.Now()
+		diags = append(diags, c.credentialsDiagnostic(ctx, credentials, accountID, now))
+		diags = append(diags, c.credentialsExpiryDiagnostics(credentials, now)...)
+	}
+
 	for _, partition := range endpoints.DefaultPartitions() {
 		if partition.ID() == partitionID {
 			client.partition = partition
@@ -208,12 +248,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	client.allowedRegions = c.AllowedRegions
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.Region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
+	if recorder, err := apitrace.FromEnv(); err != nil {
+		diags = append(diags, errs.NewWarningDiagnostic("Unable to record AWS API calls", err.Error()))
+	} else if recorder != nil {
+		cfg.APIOptions = append(cfg.APIOptions, addAPITraceMiddleware(recorder))
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
@@ -222,11 +269,68 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceSettings = c.ServiceSettings
+	client.apiLimiters = make(map[string]*serviceAPILimiter)
+	for servicePackageName, settings := range c.ServiceSettings {
+		if err := settings.validate(&cfg); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring service_settings for %s: %s", servicePackageName, err)
+		}
+		if v := newServiceAPILimiter(settings); v != nil {
+			client.apiLimiters[servicePackageName] = v
+		}
+	}
 	client.stsRegion = c.STSRegion
+	client.tokenBucketRateLimiterCapacity = c.TokenBucketRateLimiterCapacity
+
+	if d := c.verifyOrganizationAllowed(ctx, client, accountID); d.HasError() {
+		return nil, append(diags, d...)
+	}
+
+	tagPolicyConfig, err := c.tagPolicyConfig(ctx, client)
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "configuring tag policy: %s", err)
+	}
+	client.tagPolicyConfig = tagPolicyConfig
 
 	return client, diags
 }
 
+// tagPolicyConfig returns the configured tag policy with the rules of the account's
+// AWS Organizations effective tag policy appended, if requested.
+func (c *Config) tagPolicyConfig(ctx context.Context, client *AWSClient) (*tftags.PolicyConfig, error) {
+	if c.TagPolicyConfig == nil || !c.TagPolicyConfig.UseOrganizationsPolicy {
+		return c.TagPolicyConfig, nil
+	}
+
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: organizationstypes.EffectivePolicyTypeTagPolicy,
+	}
+	output, err := client.OrganizationsClient(ctx).DescribeEffectivePolicy(ctx, input)
+
+	if errs.IsA[*organizationstypes.EffectivePolicyNotFoundException](err) {
+		tflog.Info(ctx, "No AWS Organizations effective tag policy found")
+		return c.TagPolicyConfig, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS Organizations effective tag policy: %w", err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return c.TagPolicyConfig, nil
+	}
+
+	rules, err := tftags.ParseOrganizationsPolicy(aws.ToString(output.EffectivePolicy.PolicyContent))
+	if err != nil {
+		return nil, err
+	}
+
+	config := *c.TagPolicyConfig
+	config.Rules = append(slices.Clone(config.Rules), rules...)
+
+	return &config, nil
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
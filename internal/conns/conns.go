@@ -31,7 +31,8 @@ type (
 )
 
 var (
-	contextKey contextKeyType
+	contextKey       contextKeyType
+	regionContextKey contextKeyType = 1
 )
 
 // InContext represents the resource information kept in Context.
@@ -40,32 +41,36 @@ type InContext struct {
 	IsEphemeralResource bool   // Ephemeral resource?
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
+	TypeName            string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, typeName, resourceName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, typeName, resourceName string) context.Context {
 	v := InContext{
 		IsEphemeralResource: true,
 		ResourceName:        resourceName,
 		ServicePackageName:  servicePackageName,
+		TypeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, typeName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// credentialsExpiryWindow is how long before expiry cached credentials are proactively refreshed.
+	credentialsExpiryWindow = 5 * time.Minute
+	// credentialsRefreshInterval is the minimum time between proactive refreshes,
+	// so that a source that returns short-lived credentials (e.g. an SSO session near expiry) isn't called on every request.
+	credentialsRefreshInterval = 1 * time.Minute
+)
+
+// refreshingCredentialsProvider proactively refreshes cached credentials that are about to expire,
+// so that long-running applies don't fail when credentials expire mid-operation.
+// If a refresh fails the current credentials continue to be used until they expire.
+type refreshingCredentialsProvider struct {
+	cache        *aws.CredentialsCache
+	expiryWindow time.Duration
+	last         aws.Credentials // Last successfully retrieved credentials.
+	lock         sync.Mutex
+	nextRefresh  time.Time
+	now          func() time.Time
+	refreshErr   error // Error from the last proactive refresh, if any.
+}
+
+var _ aws.CredentialsProvider = (*refreshingCredentialsProvider)(nil)
+
+func newRefreshingCredentialsProvider(provider aws.CredentialsProvider) *refreshingCredentialsProvider {
+	cache, ok := provider.(*aws.CredentialsCache)
+	if !ok {
+		cache = aws.NewCredentialsCache(provider)
+	}
+
+	return &refreshingCredentialsProvider{
+		cache:        cache,
+		expiryWindow: credentialsExpiryWindow,
+		now:          time.Now,
+	}
+}
+
+func (p *refreshingCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+
+	// Don't retry a failed proactive refresh on every request.
+	if p.refreshErr != nil && now.Before(p.nextRefresh) && p.last.Expires.After(now) {
+		return p.last, nil
+	}
+
+	credentials, err := p.cache.Retrieve(ctx)
+
+	if err != nil {
+		// A previous proactive refresh failed. Use the current credentials until they expire.
+		if p.last.HasKeys() && (!p.last.CanExpire || p.last.Expires.After(now)) {
+			p.nextRefresh = now.Add(credentialsRefreshInterval)
+			p.refreshErr = err
+
+			tflog.Warn(ctx, "Retrieving AWS credentials, using current credentials until expiry", map[string]any{
+				"tf_aws.credentials.source":  p.last.Source,
+				"tf_aws.credentials.expires": p.last.Expires,
+				"error":                      err.Error(),
+			})
+
+			return p.last, nil
+		}
+
+		if source := p.last.Source; source != "" {
+			return aws.Credentials{}, fmt.Errorf("retrieving AWS credentials (%s): %w", source, err)
+		}
+
+		return aws.Credentials{}, fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	p.refreshErr = nil
+
+	if credentials.CanExpire && credentials.Expires.Sub(now) <= p.expiryWindow && !now.Before(p.nextRefresh) {
+		p.nextRefresh = now.Add(credentialsRefreshInterval)
+		p.cache.Invalidate()
+
+		refreshed, err := p.cache.Retrieve(ctx)
+		p.refreshErr = err
+
+		if err != nil {
+			tflog.Warn(ctx, "Refreshing AWS credentials before expiry", map[string]any{
+				"tf_aws.credentials.source":  credentials.Source,
+				"tf_aws.credentials.expires": credentials.Expires,
+				"error":                      err.Error(),
+			})
+		} else {
+			tflog.Info(ctx, "Refreshed AWS credentials before expiry", map[string]any{
+				"tf_aws.credentials.source":  refreshed.Source,
+				"tf_aws.credentials.expires": refreshed.Expires,
+			})
+
+			credentials = refreshed
+		}
+	}
+
+	p.last = credentials
+
+	return credentials, nil
+}
+
+// credentialsDiagnostic returns a warning diagnostic describing the resolved credentials.
+func (c *Config) credentialsDiagnostic(ctx context.Context, credentials aws.Credentials, accountID string, now time.Time) diag.Diagnostic {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Credential source: %s\n", credentials.Source)
+	if c.Profile != "" {
+		fmt.Fprintf(&sb, "Profile: %s\n", c.Profile)
+	}
+	if accountID != "" {
+		fmt.Fprintf(&sb, "Account ID: %s\n", accountID)
+	}
+
+	if chain := c.credentialsChain(ctx, credentials); len(chain) > 0 {
+		sb.WriteString("Credentials chain:\n")
+		for i, v := range chain {
+			fmt.Fprintf(&sb, "  %d. %s", i+1, v.description)
+			if !v.expires.IsZero() {
+				fmt.Fprintf(&sb, ", expires %s (in %s)", v.expires.UTC().Format(time.RFC3339), v.expires.Sub(now).Round(time.Second))
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	if credentials.CanExpire {
+		fmt.Fprintf(&sb, "Credentials expire: %s (in %s)\n", credentials.Expires.UTC().Format(time.RFC3339), credentials.Expires.Sub(now).Round(time.Second))
+		fmt.Fprintf(&sb, "Credentials are refreshed when within %s of expiry.", credentialsExpiryWindow)
+	} else {
+		sb.WriteString("Credentials do not expire.")
+	}
+
+	return errs.NewWarningDiagnostic("AWS credentials", sb.String())
+}
+
+// credentialsExpiryDiagnostics returns a warning diagnostic if the credentials expire before
+// the longest resource operation timeout elapses.
+func (c *Config) credentialsExpiryDiagnostics(credentials aws.Credentials, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !credentials.CanExpire || c.MaxResourceTimeout <= 0 || !credentials.Expires.Before(now.Add(c.MaxResourceTimeout)) {
+		return diags
+	}
+
+	return sdkdiag.AppendWarningf(diags, "The AWS credentials from %s expire at %s, before the longest resource operation timeout (%s) would elapse. "+
+		"The provider refreshes credentials before they expire; if the credential source cannot provide new credentials, "+
+		"e.g. because an SSO session has ended, long-running operations will fail.",
+		credentials.Source, credentials.Expires.UTC().Format(time.RFC3339), c.MaxResourceTimeout)
+}
+
+// credentialsSession is a session in the chain through which the provider's credentials were resolved.
+type credentialsSession struct {
+	description string
+	expires     time.Time // Zero if not known.
+}
+
+// credentialsChain returns the chain through which the provider's credentials were resolved,
+// from the source of credentials to the session whose credentials the provider uses.
+// The chain includes the shared configuration profile's `source_profile` chain, SSO session and `credential_process`,
+// followed by the configured `assume_role_with_web_identity` and `assume_role` sessions.
+// Only the expiry of the final session and of SSO access tokens are known.
+func (c *Config) credentialsChain(ctx context.Context, credentials aws.Credentials) []credentialsSession {
+	var chain []credentialsSession
+
+	if c.AccessKey == "" {
+		profile := c.Profile
+		if profile == "" {
+			profile = os.Getenv("AWS_PROFILE")
+		}
+
+		if profile != "" {
+			v, err := config.LoadSharedConfigProfile(ctx, profile, func(o *config.LoadSharedConfigOptions) {
+				if len(c.SharedConfigFiles) > 0 {
+					o.ConfigFiles = c.SharedConfigFiles
+				}
+				if len(c.SharedCredentialsFiles) > 0 {
+					o.CredentialsFiles = c.SharedCredentialsFiles
+				}
+			})
+
+			if err != nil {
+				tflog.Debug(ctx, "Loading shared configuration profile", map[string]any{
+					"tf_aws.profile": profile,
+					"error":          err.Error(),
+				})
+			} else {
+				chain = sharedConfigCredentialsChain(&v)
+			}
+		}
+	}
+
+	if v := c.AssumeRoleWithWebIdentity; v != nil && v.RoleARN != "" {
+		chain = append(chain, credentialsSession{
+			description: assumedRoleString(v.RoleARN, v.SessionName, v.Duration) + " (web identity)",
+		})
+	}
+	for _, v := range c.AssumeRole {
+		chain = append(chain, credentialsSession{
+			description: assumedRoleString(v.RoleARN, v.SessionName, v.Duration),
+		})
+	}
+
+	if n := len(chain); n > 0 && credentials.CanExpire {
+		chain[n-1].expires = credentials.Expires
+	}
+
+	return chain
+}
+
+// sharedConfigCredentialsChain returns the chain through which a shared configuration profile's credentials are resolved.
+func sharedConfigCredentialsChain(v *config.SharedConfig) []credentialsSession {
+	var chain []credentialsSession
+
+	switch {
+	case v.Source != nil:
+		chain = sharedConfigCredentialsChain(v.Source)
+	case v.SSOSession != nil:
+		chain = append(chain, credentialsSession{
+			description: fmt.Sprintf("SSO session %q (%s), account %s, role %s (profile %q)", v.SSOSession.Name, v.SSOSession.SSOStartURL, v.SSOAccountID, v.SSORoleName, v.Profile),
+			expires:     ssoTokenExpiry(v.SSOSession.Name),
+		})
+	case v.SSOStartURL != "":
+		chain = append(chain, credentialsSession{
+			description: fmt.Sprintf("SSO (%s), account %s, role %s (profile %q)", v.SSOStartURL, v.SSOAccountID, v.SSORoleName, v.Profile),
+			expires:     ssoTokenExpiry(v.SSOStartURL),
+		})
+	case v.CredentialProcess != "":
+		chain = append(chain, credentialsSession{
+			description: fmt.Sprintf("credential_process (profile %q)", v.Profile),
+		})
+	case v.CredentialSource != "":
+		chain = append(chain, credentialsSession{
+			description: fmt.Sprintf("credential_source %s (profile %q)", v.CredentialSource, v.Profile),
+		})
+	case v.Credentials.HasKeys():
+		chain = append(chain, credentialsSession{
+			description: fmt.Sprintf("static credentials (profile %q)", v.Profile),
+		})
+	}
+
+	if v.RoleARN != "" {
+		var duration time.Duration
+		if v.RoleDurationSeconds != nil {
+			duration = *v.RoleDurationSeconds
+		}
+		description := assumedRoleString(v.RoleARN, v.RoleSessionName, duration)
+		if v.WebIdentityTokenFile != "" {
+			description += " (web identity)"
+		}
+		chain = append(chain, credentialsSession{
+			description: fmt.Sprintf("%s (profile %q)", description, v.Profile),
+		})
+	}
+
+	return chain
+}
+
+// ssoTokenExpiry returns the expiry of the cached SSO access token for the specified SSO session name or start URL,
+// or the zero time if there is no cached token.
+func ssoTokenExpiry(key string) time.Time {
+	path, err := ssocreds.StandardCachedTokenFilepath(key)
+	if err != nil {
+		return time.Time{}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	var token struct {
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	if err := json.Unmarshal(b, &token); err != nil {
+		return time.Time{}
+	}
+
+	return token.ExpiresAt
+}
+
+func assumedRoleString(roleARN, sessionName string, duration time.Duration) string {
+	s := roleARN
+	if sessionName != "" {
+		s += fmt.Sprintf(", session name %q", sessionName)
+	}
+	if duration > 0 {
+		s += fmt.Sprintf(", duration %s", duration)
+	}
+
+	return s
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type testCredentialsProvider struct {
+	calls   int
+	results []testCredentialsResult
+}
+
+type testCredentialsResult struct {
+	expiresIn time.Duration
+	err       error
+}
+
+func (p *testCredentialsProvider) Retrieve(context.Context) (aws.Credentials, error) {
+	result := p.results[min(p.calls, len(p.results)-1)]
+	p.calls++
+
+	if result.err != nil {
+		return aws.Credentials{}, result.err
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		Source:          "TestProvider",
+		CanExpire:       true,
+		Expires:         time.Now().Add(result.expiresIn),
+	}, nil
+}
+
+func TestRefreshingCredentialsProviderRefreshesNearExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := &testCredentialsProvider{
+		results: []testCredentialsResult{
+			{expiresIn: 2 * time.Minute},
+			{expiresIn: 1 * time.Hour},
+		},
+	}
+	p := newRefreshingCredentialsProvider(source)
+
+	credentials, err := p.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := source.calls, 2; got != want {
+		t.Errorf("%d calls to credentials source, want %d", got, want)
+	}
+	if remaining := time.Until(credentials.Expires); remaining <= credentialsExpiryWindow {
+		t.Errorf("credentials expire in %s, want refreshed credentials", remaining)
+	}
+
+	// Cached credentials aren't near expiry.
+	if _, err := p.Retrieve(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := source.calls, 2; got != want {
+		t.Errorf("%d calls to credentials source, want %d", got, want)
+	}
+}
+
+func TestRefreshingCredentialsProviderRefreshFails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := &testCredentialsProvider{
+		results: []testCredentialsResult{
+			{expiresIn: 2 * time.Minute},
+			{err: errors.New("SSO session has expired")},
+		},
+	}
+	p := newRefreshingCredentialsProvider(source)
+
+	credentials, err := p.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if remaining := time.Until(credentials.Expires); remaining > credentialsExpiryWindow {
+		t.Errorf("credentials expire in %s, want current credentials", remaining)
+	}
+
+	// A failed refresh isn't retried until the refresh interval has elapsed.
+	if _, err := p.Retrieve(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := source.calls, 2; got != want {
+		t.Errorf("%d calls to credentials source, want %d", got, want)
+	}
+
+	// Once the current credentials have expired the error is returned.
+	p.now = func() time.Time { return time.Now().Add(time.Hour) }
+	p.cache.Invalidate()
+
+	if _, err := p.Retrieve(ctx); err == nil {
+		t.Fatal("expected error, got none")
+	} else if !strings.Contains(err.Error(), "TestProvider") {
+		t.Errorf("error %q does not contain credentials source", err)
+	}
+}
+
+func TestConfigCredentialsDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := &Config{
+		AssumeRole: []awsbase.AssumeRole{
+			{RoleARN: "arn:aws:iam::123456789012:role/first", SessionName: "first-session"}, //lintignore:AWSAT005
+			{RoleARN: "arn:aws:iam::123456789012:role/second", Duration: time.Hour},         //lintignore:AWSAT005
+		},
+		AssumeRoleWithWebIdentity: &awsbase.AssumeRoleWithWebIdentity{
+			RoleARN: "arn:aws:iam::123456789012:role/web", //lintignore:AWSAT005
+		},
+		Profile:                "test",
+		SharedConfigFiles:      []string{filepath.Join(t.TempDir(), "config")},
+		SharedCredentialsFiles: []string{filepath.Join(t.TempDir(), "credentials")},
+	}
+	credentials := aws.Credentials{
+		Source:    "AssumeRoleProvider",
+		CanExpire: true,
+		Expires:   now.Add(30 * time.Minute),
+	}
+
+	d := c.credentialsDiagnostic(ctx, credentials, "123456789012", now)
+
+	if got, want := d.Severity, diag.Warning; got != want {
+		t.Errorf("Severity = %v, want %v", got, want)
+	}
+
+	for _, want := range []string{
+		"Credential source: AssumeRoleProvider",
+		"Profile: test",
+		"Account ID: 123456789012",
+		`1. arn:aws:iam::123456789012:role/web (web identity)`,                  //lintignore:AWSAT005
+		`2. arn:aws:iam::123456789012:role/first, session name "first-session"`, //lintignore:AWSAT005
+		"3. arn:aws:iam::123456789012:role/second, duration 1h0m0s, expires 2024-01-01T12:30:00Z (in 30m0s)", //lintignore:AWSAT005
+		"Credentials expire: 2024-01-01T12:30:00Z (in 30m0s)",
+	} {
+		if !strings.Contains(d.Detail, want) {
+			t.Errorf("Detail does not contain %q:\n%s", want, d.Detail)
+		}
+	}
+
+	credentials.CanExpire = false
+	d = c.credentialsDiagnostic(ctx, credentials, "", now)
+
+	if want := "Credentials do not expire."; !strings.Contains(d.Detail, want) {
+		t.Errorf("Detail does not contain %q:\n%s", want, d.Detail)
+	}
+}
+
+func TestConfigCredentialsDiagnosticSharedConfigProfile(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	configFile := filepath.Join(home, "config")
+	if err := os.WriteFile(configFile, []byte(`
+[profile sso]
+sso_session    = example
+sso_account_id = 123456789012
+sso_role_name  = Example
+
+[profile process]
+credential_process = /usr/local/bin/example-credentials
+
+[profile test]
+role_arn       = arn:aws:iam::123456789012:role/source
+source_profile = sso
+
+[sso-session example]
+sso_start_url = https://example.awsapps.com/start
+sso_region    = us-east-1
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenFile, err := ssocreds.StandardCachedTokenFilepath("example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(tokenFile), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(tokenFile, []byte(`{"accessToken":"token","expiresAt":"2024-01-01T20:00:00Z"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		AssumeRole: []awsbase.AssumeRole{
+			{RoleARN: "arn:aws:iam::123456789012:role/target"}, //lintignore:AWSAT005
+		},
+		Profile:                "test",
+		SharedConfigFiles:      []string{configFile},
+		SharedCredentialsFiles: []string{filepath.Join(home, "credentials")},
+	}
+	credentials := aws.Credentials{
+		Source:    "AssumeRoleProvider",
+		CanExpire: true,
+		Expires:   now.Add(time.Hour),
+	}
+
+	d := c.credentialsDiagnostic(ctx, credentials, "", now)
+
+	for _, want := range []string{
+		`1. SSO session "example" (https://example.awsapps.com/start), account 123456789012, role Example (profile "sso"), expires 2024-01-01T20:00:00Z (in 8h0m0s)`,
+		`2. arn:aws:iam::123456789012:role/source (profile "test")`,                          //lintignore:AWSAT005
+		"3. arn:aws:iam::123456789012:role/target, expires 2024-01-01T13:00:00Z (in 1h0m0s)", //lintignore:AWSAT005
+	} {
+		if !strings.Contains(d.Detail, want) {
+			t.Errorf("Detail does not contain %q:\n%s", want, d.Detail)
+		}
+	}
+
+	c = &Config{
+		Profile:                "process",
+		SharedConfigFiles:      []string{configFile},
+		SharedCredentialsFiles: []string{filepath.Join(home, "credentials")},
+	}
+	credentials = aws.Credentials{
+		Source: "ProcessProvider",
+	}
+
+	d = c.credentialsDiagnostic(ctx, credentials, "", now)
+
+	if want := `1. credential_process (profile "process")`; !strings.Contains(d.Detail, want) {
+		t.Errorf("Detail does not contain %q:\n%s", want, d.Detail)
+	}
+}
+
+func TestConfigCredentialsExpiryDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := &Config{
+		MaxResourceTimeout: time.Hour,
+	}
+
+	if diags := c.credentialsExpiryDiagnostics(aws.Credentials{CanExpire: true, Expires: now.Add(2 * time.Hour)}, now); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	if diags := c.credentialsExpiryDiagnostics(aws.Credentials{}, now); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	diags := c.credentialsExpiryDiagnostics(aws.Credentials{Source: "SSOProvider", CanExpire: true, Expires: now.Add(30 * time.Minute)}, now)
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("%d diagnostics, want %d", got, want)
+	}
+	if got, want := diags[0].Severity, diag.Warning; got != want {
+		t.Errorf("Severity = %v, want %v", got, want)
+	}
+	if want := "SSOProvider expire at 2024-01-01T12:30:00Z, before the longest resource operation timeout (1h0m0s)"; !strings.Contains(diags[0].Summary, want) {
+		t.Errorf("Summary does not contain %q:\n%s", want, diags[0].Summary)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// verifyRegionAllowed returns an error if allowed Regions are configured and the specified Region is not one of them.
+func verifyRegionAllowed(region string, allowedRegions []string) error {
+	if len(allowedRegions) == 0 || slices.Contains(allowedRegions, region) {
+		return nil
+	}
+
+	return fmt.Errorf("region (%s) is not one of the allowed Regions (%s)", region, strings.Join(allowedRegions, ", "))
+}
+
+// verifyPartitionAllowed returns an error if allowed partitions are configured and the specified partition is not one of them.
+func verifyPartitionAllowed(partitionID string, allowedPartitions []string) error {
+	if len(allowedPartitions) == 0 || slices.Contains(allowedPartitions, partitionID) {
+		return nil
+	}
+
+	return fmt.Errorf("partition (%s) is not one of the allowed partitions (%s)", partitionID, strings.Join(allowedPartitions, ", "))
+}
+
+// verifyOrganizationAllowed returns diagnostics if allowed AWS Organizations organizations are configured
+// and the specified account is not a member of one of them.
+// Membership of an organizational unit is not verified: listing an account's parents is only
+// possible from the organization's management account or a delegated administrator account.
+func (c *Config) verifyOrganizationAllowed(ctx context.Context, client *AWSClient, accountID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(c.AllowedOrganizationIDs) == 0 {
+		return diags
+	}
+
+	if accountID == "" {
+		return append(diags, errs.NewErrorDiagnostic(
+			"AWS account ID required",
+			"The AWS account ID is required to verify membership of the allowed AWS Organizations organizations. "+
+				"Remove `skip_requesting_account_id` from the provider configuration."))
+	}
+
+	organizationID, err := findOrganizationID(ctx, client.OrganizationsClient(ctx))
+
+	if err != nil {
+		return append(diags, errs.NewErrorDiagnostic(
+			"Unable to verify AWS Organizations organization",
+			fmt.Sprintf("reading AWS Organizations organization for account (%s): %s", accountID, err)))
+	}
+
+	if !slices.Contains(c.AllowedOrganizationIDs, organizationID) {
+		return append(diags, errs.NewErrorDiagnostic(
+			"AWS Organizations organization not allowed",
+			fmt.Sprintf("account (%s) is a member of organization (%s), not one of the allowed organizations (%s)",
+				accountID, organizationID, strings.Join(c.AllowedOrganizationIDs, ", "))))
+	}
+
+	return diags
+}
+
+func findOrganizationID(ctx context.Context, conn *organizations.Client) (string, error) {
+	output, err := conn.DescribeOrganization(ctx, &organizations.DescribeOrganizationInput{})
+
+	if errs.IsA[*organizationstypes.AWSOrganizationsNotInUseException](err) {
+		return "", fmt.Errorf("account is not a member of an organization")
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Organization == nil {
+		return "", fmt.Errorf("empty result")
+	}
+
+	return aws.ToString(output.Organization.Id), nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Region         string
+		AllowedRegions []string
+		ExpectError    bool
+	}{
+		{
+			Name:   "none configured",
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "allowed",
+			Region:         "us-west-2",                        //lintignore:AWSAT003
+			AllowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+		},
+		{
+			Name:           "not allowed",
+			Region:         "eu-west-1",                        //lintignore:AWSAT003
+			AllowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			ExpectError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyRegionAllowed(testCase.Region, testCase.AllowedRegions)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestVerifyPartitionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name              string
+		PartitionID       string
+		AllowedPartitions []string
+		ExpectError       bool
+	}{
+		{
+			Name:        "none configured",
+			PartitionID: "aws",
+		},
+		{
+			Name:              "allowed",
+			PartitionID:       "aws-us-gov",
+			AllowedPartitions: []string{"aws-us-gov"},
+		},
+		{
+			Name:              "not allowed",
+			PartitionID:       "aws",
+			AllowedPartitions: []string{"aws-us-gov"},
+			ExpectError:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyPartitionAllowed(testCase.PartitionID, testCase.AllowedPartitions)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	// ImportIDRegionSeparator separates a resource's import ID from an optional Region override,
+	// e.g. "vpc-0123456789abcdef0@eu-west-1".
+	ImportIDRegionSeparator = "@"
+)
+
+var (
+	regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+// NewRegionContext returns a Context in which a resource's AWS API calls are made in the specified Region
+// rather than the provider's configured Region.
+func NewRegionContext(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionContextKey, region)
+}
+
+// RegionFromContext returns any per-resource Region override from Context.
+func RegionFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(regionContextKey).(string)
+	return v, ok && v != ""
+}
+
+// SplitImportIDRegion splits an import ID of the form "<id>@<region>" into the resource's ID and Region.
+// If the import ID has no Region suffix the returned Region is empty and the ID is returned unchanged.
+func SplitImportIDRegion(importID string) (string, string) {
+	i := strings.LastIndex(importID, ImportIDRegionSeparator)
+	if i < 1 {
+		return importID, ""
+	}
+
+	// IDs such as e-mail addresses can legitimately contain the separator.
+	if region := importID[i+len(ImportIDRegionSeparator):]; regionRegexp.MatchString(region) {
+		return importID[:i], region
+	}
+
+	return importID, ""
+}
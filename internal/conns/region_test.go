@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		importID       string
+		expectedID     string
+		expectedRegion string
+	}{
+		{
+			name:       "empty",
+			importID:   "",
+			expectedID: "",
+		},
+		{
+			name:       "no Region",
+			importID:   "vpc-0123456789abcdef0",
+			expectedID: "vpc-0123456789abcdef0",
+		},
+		{
+			name:           "Region",
+			importID:       "vpc-0123456789abcdef0@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-0123456789abcdef0",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:           "GovCloud Region",
+			importID:       "vpc-0123456789abcdef0@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-0123456789abcdef0",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:           "multi-part ID",
+			importID:       "us-west-2a,snap-0123456789abcdef0@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "us-west-2a,snap-0123456789abcdef0",           //lintignore:AWSAT003
+			expectedRegion: "eu-west-1",                                   //lintignore:AWSAT003
+		},
+		{
+			name:       "e-mail address",
+			importID:   "someone@example.com",
+			expectedID: "someone@example.com",
+		},
+		{
+			name:           "e-mail address and Region",
+			importID:       "someone@example.com@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "someone@example.com",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:       "separator only",
+			importID:   "@eu-west-1", //lintignore:AWSAT003
+			expectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.importID)
+
+			if gotID != testCase.expectedID {
+				t.Errorf("ID: got %s, expected %s", gotID, testCase.expectedID)
+			}
+			if gotRegion != testCase.expectedRegion {
+				t.Errorf("Region: got %s, expected %s", gotRegion, testCase.expectedRegion)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+)
+
+// ServiceSettings contains a service's overrides of the provider-level AWS API client configuration.
+// Zero values mean that the provider-level configuration applies.
+type ServiceSettings struct {
+	// Limits are client-side limits on all API requests to the service.
+	Limits     APILimits
+	MaxRetries int
+	// OperationLimits are client-side limits on API requests for individual operations, keyed by operation name, e.g. "ChangeResourceRecordSets".
+	OperationLimits map[string]APILimits
+	// RetryableErrorCodes are API error codes that are retried in addition to the AWS SDK's defaults.
+	RetryableErrorCodes            []string
+	RetryMode                      aws.RetryMode
+	Timeout                        time.Duration
+	TokenBucketRateLimiterCapacity int
+}
+
+// validate returns an error if the service settings can't be applied to the specified AWS SDK for Go v2 configuration.
+func (s ServiceSettings) validate(cfg *aws.Config) error {
+	if s.Timeout > 0 {
+		if _, ok := cfg.HTTPClient.(*awshttp.BuildableClient); !ok {
+			return fmt.Errorf("timeout can't be set on HTTP client of type %T", cfg.HTTPClient)
+		}
+	}
+
+	return nil
+}
+
+// awsConfig returns a copy of the specified AWS SDK for Go v2 configuration with the service settings applied.
+// tokenBucketRateLimiterCapacity is the provider-level token bucket rate limiter capacity.
+func (s ServiceSettings) awsConfig(cfg *aws.Config, tokenBucketRateLimiterCapacity int) *aws.Config {
+	v := cfg.Copy()
+
+	if s.MaxRetries > 0 || len(s.RetryableErrorCodes) > 0 || s.RetryMode != "" || s.TokenBucketRateLimiterCapacity > 0 {
+		v.Retryer = s.retryer(cfg.Retryer, tokenBucketRateLimiterCapacity)
+	}
+
+	// The HTTP client's type is checked when the provider is configured.
+	if httpClient, ok := v.HTTPClient.(*awshttp.BuildableClient); ok && s.Timeout > 0 {
+		v.HTTPClient = httpClient.WithTimeout(s.Timeout)
+	}
+
+	return &v
+}
+
+// retryer returns a function that creates the service's retryer from the provider-level retryer.
+// The provider-level retryer is only replaced if the service overrides the retry mode or rate limiter,
+// otherwise the service's maximum attempts and retryable error codes wrap the provider-level retryer.
+// tokenBucketRateLimiterCapacity is the provider-level token bucket rate limiter capacity,
+// used if the service overrides the retry mode but not the rate limiter.
+func (s ServiceSettings) retryer(newBaseRetryer func() aws.Retryer, tokenBucketRateLimiterCapacity int) func() aws.Retryer {
+	return func() aws.Retryer {
+		var r aws.Retryer
+		if newBaseRetryer != nil {
+			r = newBaseRetryer()
+		}
+
+		if r == nil || s.RetryMode != "" || s.TokenBucketRateLimiterCapacity > 0 {
+			maxAttempts := s.MaxRetries
+			if maxAttempts == 0 && r != nil {
+				maxAttempts = r.MaxAttempts()
+			}
+
+			capacity := s.TokenBucketRateLimiterCapacity
+			if capacity == 0 {
+				capacity = tokenBucketRateLimiterCapacity
+			}
+
+			standardOptions := func(o *retry.StandardOptions) {
+				o.Backoff = &v1CompatibleBackoff{maxRetryDelay: maxBackoff}
+				o.MaxBackoff = maxBackoff
+				if maxAttempts > 0 {
+					o.MaxAttempts = maxAttempts
+				}
+				if capacity > 0 {
+					o.RateLimiter = ratelimit.NewTokenRateLimit(uint(capacity))
+				} else {
+					o.RateLimiter = ratelimit.None
+				}
+			}
+
+			if s.RetryMode == aws.RetryModeAdaptive {
+				r = retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+					o.StandardOptions = append(o.StandardOptions, standardOptions)
+				})
+			} else {
+				r = retry.NewStandard(standardOptions)
+			}
+		} else if s.MaxRetries > 0 {
+			r = retry.AddWithMaxAttempts(r, s.MaxRetries)
+		}
+
+		if len(s.RetryableErrorCodes) > 0 {
+			r = retry.AddWithErrorCodes(r, s.RetryableErrorCodes...)
+		}
+
+		return r
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+)
+
+func TestServiceSettingsAWSConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws.Config{
+		HTTPClient: awshttp.NewBuildableClient(),
+		Retryer: func() aws.Retryer {
+			return retry.AddWithErrorCodes(retry.AddWithMaxAttempts(retry.NewStandard(), 25), "BaseException")
+		},
+	}
+	apiErr := &smithy.GenericAPIError{Code: "ConcurrentModificationException"}
+	baseAPIErr := &smithy.GenericAPIError{Code: "BaseException"}
+
+	testCases := []struct {
+		name                           string
+		settings                       ServiceSettings
+		tokenBucketRateLimiterCapacity int
+		wantMaxAttempts                int
+		wantBaseRetryable              bool
+		wantRetryable                  bool
+		wantTimeout                    time.Duration
+	}{
+		{
+			name:              "no overrides",
+			wantMaxAttempts:   25,
+			wantBaseRetryable: true,
+		},
+		{
+			name: "max retries",
+			settings: ServiceSettings{
+				MaxRetries: 5,
+			},
+			wantMaxAttempts:   5,
+			wantBaseRetryable: true,
+		},
+		{
+			name: "max retries with provider-level rate limiter",
+			settings: ServiceSettings{
+				MaxRetries: 5,
+			},
+			tokenBucketRateLimiterCapacity: 100,
+			wantMaxAttempts:                5,
+			wantBaseRetryable:              true,
+		},
+		{
+			name: "retryable error codes",
+			settings: ServiceSettings{
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			wantMaxAttempts:   25,
+			wantBaseRetryable: true,
+			wantRetryable:     true,
+		},
+		{
+			name: "retry mode",
+			settings: ServiceSettings{
+				RetryMode: aws.RetryModeAdaptive,
+			},
+			wantMaxAttempts: 25,
+		},
+		{
+			name: "rate limiter and max retries",
+			settings: ServiceSettings{
+				MaxRetries:                     10,
+				TokenBucketRateLimiterCapacity: 100,
+			},
+			wantMaxAttempts: 10,
+		},
+		{
+			name: "timeout",
+			settings: ServiceSettings{
+				Timeout: 30 * time.Second,
+			},
+			wantMaxAttempts:   25,
+			wantBaseRetryable: true,
+			wantTimeout:       30 * time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := testCase.settings.awsConfig(cfg, testCase.tokenBucketRateLimiterCapacity)
+
+			if v == cfg {
+				t.Fatal("expected a copy of the AWS configuration")
+			}
+
+			retryer := v.Retryer()
+
+			if got, want := retryer.MaxAttempts(), testCase.wantMaxAttempts; got != want {
+				t.Errorf("MaxAttempts = %d, want %d", got, want)
+			}
+
+			if got, want := retryer.IsErrorRetryable(baseAPIErr), testCase.wantBaseRetryable; got != want {
+				t.Errorf("IsErrorRetryable(base) = %t, want %t", got, want)
+			}
+
+			if got, want := retryer.IsErrorRetryable(apiErr), testCase.wantRetryable; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+
+			if got, want := v.HTTPClient.(*awshttp.BuildableClient).GetTimeout(), testCase.wantTimeout; got != want {
+				t.Errorf("Timeout = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestServiceSettingsValidate(t *testing.T) {
+	t.Parallel()
+
+	settings := ServiceSettings{
+		Timeout: 30 * time.Second,
+	}
+
+	if err := settings.validate(&aws.Config{HTTPClient: awshttp.NewBuildableClient()}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := settings.validate(&aws.Config{HTTPClient: &http.Client{}}); err == nil {
+		t.Error("expected error")
+	}
+
+	if err := (ServiceSettings{}).validate(&aws.Config{HTTPClient: &http.Client{}}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -66,6 +66,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests against a local AWS emulator, the emulator's endpoint URL
+	// All service endpoints are overridden with this URL
+	AccEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +88,27 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to tune resource sweepers
+const (
+	// The maximum number of resources a sweeper deletes concurrently.
+	// Defaults to 10.
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// If true, sweepers log the resources they would delete without deleting them.
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The minimum age of resources to sweep, as a Go duration, e.g. 24h.
+	// Resources whose creation time is not known are not swept.
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// A regular expression that the names, or IDs if they have no name, of resources to sweep must match.
+	SweepNameRegex = "TF_AWS_SWEEP_NAME_REGEX"
+
+	// A comma-separated list of tag filters, each either key=value or key, that resources to sweep must match.
+	// Resources whose tags are not known are not swept.
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
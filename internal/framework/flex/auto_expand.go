@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 // Expand  = TF -->  AWS
@@ -136,6 +135,14 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	if _, ok := valFrom.Interface().(attr.Value); !ok && valFrom.Kind() == reflect.Struct && vTo.Kind() == reflect.Interface {
+		if tMembers, ok := unionMemberTypes(vTo.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, vTo, tMembers, expander)...)
+			return diags
+		}
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
@@ -485,13 +492,26 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 
 	case reflect.Interface:
-		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
-			v, d := s.ValueInterface()
+		//
+		// fwtypes.SmithyJSON[T] -> document.Interface (or smithyjson.JSONStringer).
+		//
+		if s, ok := vFrom.(fwtypes.SmithyDocumentValuable); ok {
+			v, d := s.ValueSmithyDocument()
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
+			if v == nil {
+				return diags
+			}
+
+			if tDoc := reflect.TypeOf(v); !tDoc.AssignableTo(tTo) {
+				tflog.SubsystemError(ctx, subsystemName, "Expanding incompatible types")
+				diags.Append(diagExpandingIncompatibleTypes(tDoc, tTo))
+				return diags
+			}
+
 			vTo.Set(reflect.ValueOf(v))
 			return diags
 		}
@@ -508,6 +528,9 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 					return diags
 				}
 			}
+			if fieldOpts.omitempty && len(v.ValueString()) == 0 {
+				return diags
+			}
 			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
 			return diags
 
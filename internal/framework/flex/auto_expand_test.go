@@ -562,6 +562,35 @@ func TestExpand(t *testing.T) {
 				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.SmithyJSON[smithyjson.JSONStringer]](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
 			},
 		},
+		"JSONValue Source to document interface Target": {
+			Source: &tfDocument{Field1: fwtypes.SmithyJSONValue(`{"field1": "a"}`, newTestDocument)},
+			Target: &awsDocument{},
+			WantTarget: &awsDocument{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfDocument](), reflect.TypeFor[*awsDocument]()),
+				infoConverting(reflect.TypeFor[tfDocument](), reflect.TypeFor[*awsDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfDocument](), "Field1", reflect.TypeFor[*awsDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.SmithyJSON[testDocumentInterface]](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+			},
+		},
+		"null JSONValue Source to document interface Target": {
+			Source:     &tfDocument{Field1: fwtypes.SmithyJSONNull[testDocumentInterface]()},
+			Target:     &awsDocument{},
+			WantTarget: &awsDocument{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfDocument](), reflect.TypeFor[*awsDocument]()),
+				infoConverting(reflect.TypeFor[tfDocument](), reflect.TypeFor[*awsDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfDocument](), "Field1", reflect.TypeFor[*awsDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.SmithyJSON[testDocumentInterface]](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+				traceExpandingNullValue("Field1", reflect.TypeFor[fwtypes.SmithyJSON[testDocumentInterface]](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+			},
+		},
 	}
 
 	runAutoExpandTestCases(t, testCases)
@@ -2880,6 +2909,39 @@ func TestExpandString(t *testing.T) {
 				},
 			},
 		},
+
+		"omitempty String to *string": {
+			"value": {
+				Source: tfSingleStringFieldOmitEmpty{
+					Field1: types.StringValue("value"),
+				},
+				Target: &awsSingleStringPointer{},
+				WantTarget: &awsSingleStringPointer{
+					Field1: aws.String("value"),
+				},
+				expectedLogLines: []map[string]any{
+					infoExpanding(reflect.TypeFor[tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+					infoConverting(reflect.TypeFor[tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+					traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldOmitEmpty](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+					infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				},
+			},
+			"empty": {
+				Source: tfSingleStringFieldOmitEmpty{
+					Field1: types.StringValue(""),
+				},
+				Target: &awsSingleStringPointer{},
+				WantTarget: &awsSingleStringPointer{
+					Field1: nil,
+				},
+				expectedLogLines: []map[string]any{
+					infoExpanding(reflect.TypeFor[tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+					infoConverting(reflect.TypeFor[tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+					traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldOmitEmpty](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+					infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				},
+			},
+		},
 	}
 
 	for testName, cases := range testCases {
@@ -4607,6 +4669,79 @@ func TestExpandIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"to value": {
+			Source: tfSingleStringFieldRenamed{
+				Name: types.StringValue("value1"),
+			},
+			Target: &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "value1",
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldRenamed](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldRenamed](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceSourceFieldNameOverride("Name", reflect.TypeFor[tfSingleStringFieldRenamed](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				traceMatchedFields("Name", reflect.TypeFor[tfSingleStringFieldRenamed](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"omitempty to pointer": {
+			Source: tfSingleStringFieldRenamedOmitEmpty{
+				Name: types.StringValue(""),
+			},
+			Target:     &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldRenamedOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldRenamedOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+				traceSourceFieldNameOverride("Name", reflect.TypeFor[tfSingleStringFieldRenamedOmitEmpty](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+				traceMatchedFields("Name", reflect.TypeFor[tfSingleStringFieldRenamedOmitEmpty](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+			},
+		},
+		"no fuzzy match": {
+			Source: tfSingleStringFieldRenamedCaseInsensitive{
+				Name: types.StringValue("value1"),
+			},
+			Target:     &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringFieldRenamedCaseInsensitive](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldRenamedCaseInsensitive](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceSourceFieldNameOverride("Name", reflect.TypeFor[tfSingleStringFieldRenamedCaseInsensitive](), "field1", reflect.TypeFor[*awsSingleStringValue]()),
+				debugNoCorrespondingField(reflect.TypeFor[tfSingleStringFieldRenamedCaseInsensitive](), "Name", reflect.TypeFor[*awsSingleStringValue]()),
+			},
+		},
+		"swapped": {
+			Source: tfSwappedStringFields{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+			},
+			Target: &awsTwoStringValues{},
+			WantTarget: &awsTwoStringValues{
+				Field1: "value2",
+				Field2: "value1",
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSwappedStringFields](), reflect.TypeFor[*awsTwoStringValues]()),
+				infoConverting(reflect.TypeFor[tfSwappedStringFields](), reflect.TypeFor[*awsTwoStringValues]()),
+				traceSourceFieldNameOverride("Field1", reflect.TypeFor[tfSwappedStringFields](), "Field2", reflect.TypeFor[*awsTwoStringValues]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSwappedStringFields](), "Field2", reflect.TypeFor[*awsTwoStringValues]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field2", reflect.TypeFor[string]()),
+				traceSourceFieldNameOverride("Field2", reflect.TypeFor[tfSwappedStringFields](), "Field1", reflect.TypeFor[*awsTwoStringValues]()),
+				traceMatchedFields("Field2", reflect.TypeFor[tfSwappedStringFields](), "Field1", reflect.TypeFor[*awsTwoStringValues]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -4335,6 +4335,64 @@ func TestFlattenIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestFlattenNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"from value": {
+			Source: awsSingleStringValue{
+				Field1: "value1",
+			},
+			Target: &tfSingleStringFieldRenamed{},
+			WantTarget: &tfSingleStringFieldRenamed{
+				Name: types.StringValue("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsSingleStringValue](), reflect.TypeFor[*tfSingleStringFieldRenamed]()),
+				infoConverting(reflect.TypeFor[awsSingleStringValue](), reflect.TypeFor[*tfSingleStringFieldRenamed]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsSingleStringValue](), "Name", reflect.TypeFor[*tfSingleStringFieldRenamed]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Name", reflect.TypeFor[types.String]()),
+			},
+		},
+		"omitempty from pointer": {
+			Source: awsSingleStringPointer{
+				Field1: aws.String(""),
+			},
+			Target: &tfSingleStringFieldRenamedOmitEmpty{},
+			WantTarget: &tfSingleStringFieldRenamedOmitEmpty{
+				Name: types.StringNull(),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsSingleStringPointer](), reflect.TypeFor[*tfSingleStringFieldRenamedOmitEmpty]()),
+				infoConverting(reflect.TypeFor[awsSingleStringPointer](), reflect.TypeFor[*tfSingleStringFieldRenamedOmitEmpty]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsSingleStringPointer](), "Name", reflect.TypeFor[*tfSingleStringFieldRenamedOmitEmpty]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Name", reflect.TypeFor[types.String]()),
+			},
+		},
+		"swapped": {
+			Source: awsTwoStringValues{
+				Field1: "value1",
+				Field2: "value2",
+			},
+			Target: &tfSwappedStringFields{},
+			WantTarget: &tfSwappedStringFields{
+				Field1: types.StringValue("value2"),
+				Field2: types.StringValue("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSwappedStringFields]()),
+				infoConverting(reflect.TypeFor[awsTwoStringValues](), reflect.TypeFor[*tfSwappedStringFields]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsTwoStringValues](), "Field2", reflect.TypeFor[*tfSwappedStringFields]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field2", reflect.TypeFor[types.String]()),
+				traceMatchedFields("Field2", reflect.TypeFor[awsTwoStringValues](), "Field1", reflect.TypeFor[*tfSwappedStringFields]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[string](), "Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenInterfaceToStringTypable(t *testing.T) {
 	t.Parallel()
 
@@ -4404,6 +4462,24 @@ func TestFlattenInterfaceToStringTypable(t *testing.T) {
 				infoSourceImplementsJSONStringer("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[fwtypes.SmithyJSON[smithyjson.JSONStringer]]()), // TODO: fix source type
 			},
 		},
+		"document interface Source JSONValue Target": {
+			Source: &awsDocument{
+				Field1: newTestDocument(map[string]any{
+					"test": "a",
+				}),
+			},
+			Target: &tfDocument{},
+			WantTarget: &tfDocument{
+				Field1: fwtypes.SmithyJSONValue(`{"test":"a"}`, newTestDocument),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsDocument](), reflect.TypeFor[*tfDocument]()),
+				infoConverting(reflect.TypeFor[awsDocument](), reflect.TypeFor[*tfDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsDocument](), "Field1", reflect.TypeFor[*tfDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[testDocumentInterface](), "Field1", reflect.TypeFor[fwtypes.SmithyJSON[testDocumentInterface]]()),
+				infoSourceImplementsJSONStringer("Field1", reflect.TypeFor[testDocumentInterface](), "Field1", reflect.TypeFor[fwtypes.SmithyJSON[testDocumentInterface]]()), // TODO: fix source type
+			},
+		},
 		"null json interface Source JSONValue Target": {
 			Source: &awsJSONStringer{
 				Field1: nil,
@@ -102,6 +102,12 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if tMembers, ok := unionMemberTypes(valTo.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, tMembers, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -116,6 +122,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if tUnion, ok := unionTypeOf(valFrom.Type()); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source is a union member")
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, tUnion, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -147,7 +160,18 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			continue
 		}
 
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		var toField reflect.StructField
+		var ok bool
+		if fromNameOverride != "" {
+			// A field name override is matched exactly.
+			tflog.SubsystemTrace(ctx, subsystemName, "Using source field name override", map[string]any{
+				logAttrKeySourceFieldname: fieldName,
+				logAttrKeyTargetFieldname: fromNameOverride,
+			})
+			toField, ok = fieldByName(fromNameOverride, typeTo)
+		} else {
+			toField, ok = findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		}
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -189,7 +213,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 		opts := fieldOpts{
 			legacy:    fromOpts.Legacy() || toOpts.Legacy(),
-			omitempty: toOpts.OmitEmpty(),
+			omitempty: fromOpts.OmitEmpty() || toOpts.OmitEmpty(),
 		}
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
@@ -202,8 +226,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 }
 
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
+	// zeroth precedence is a field name override, e.g. `autoflex:"FieldNameFrom"`
+	if fieldTo, ok := fieldByNameOverride(fieldNameFrom, typeTo); ok {
+		return fieldTo, true
+	}
+
 	// first precedence is exact match (case sensitive)
-	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
+	if fieldTo, ok := fieldByName(fieldNameFrom, typeTo); ok {
 		return fieldTo, true
 	}
 
@@ -224,7 +253,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 		if opts.isIgnoredField(fieldNameTo) {
 			continue
 		}
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
+		if fieldTo, ok := fieldByName(fieldNameTo, typeTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
 			// probably could assume validity here since reflect gave the field name
 			return fieldTo, true
 		}
@@ -233,14 +262,14 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 	// third precedence is singular/plural
 	fieldNameTo := plural.Plural(fieldNameFrom)
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
+		if fieldTo, ok := fieldByName(fieldNameTo, typeTo); ok {
 			return fieldTo, true
 		}
 	}
 
 	fieldNameTo = plural.Singular(fieldNameFrom)
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
+		if fieldTo, ok := fieldByName(fieldNameTo, typeTo); ok {
 			return fieldTo, true
 		}
 	}
@@ -280,10 +309,50 @@ func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	return ok
 }
 
+// fieldByName returns the struct field with the specified name.
+// Fields with a name override are only matched by that name.
+func fieldByName(name string, structType reflect.Type) (reflect.StructField, bool) {
+	field, ok := structType.FieldByName(name)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+
+	if fieldNameOverride(field) != "" {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+// fieldByNameOverride returns the exported struct field whose name override is the specified name.
+func fieldByNameOverride(name string, structType reflect.Type) (reflect.StructField, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		if fieldNameOverride(field) == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
 func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
 
+// fieldNameOverride returns the field name override, e.g. "ApiName" for `autoflex:"ApiName,omitempty"`, or the empty string.
+func fieldNameOverride(field reflect.StructField) string {
+	if name, _ := autoflexTags(field); name != "-" {
+		return name
+	}
+
+	return ""
+}
+
 type fieldOpts struct {
 	legacy    bool
 	omitempty bool
@@ -37,6 +37,28 @@ type tfSingleStringFieldLegacy struct {
 	Field1 types.String `tfsdk:"field1" autoflex:",legacy"`
 }
 
+type tfSingleStringFieldRenamed struct {
+	Name types.String `tfsdk:"name" autoflex:"Field1"`
+}
+
+type tfSingleStringFieldRenamedOmitEmpty struct {
+	Name types.String `tfsdk:"name" autoflex:"Field1,omitempty"`
+}
+
+type tfSingleStringFieldRenamedCaseInsensitive struct {
+	Name types.String `tfsdk:"name" autoflex:"field1"`
+}
+
+type tfSwappedStringFields struct {
+	Field1 types.String `tfsdk:"field1" autoflex:"Field2"`
+	Field2 types.String `tfsdk:"field2" autoflex:"Field1"`
+}
+
+type awsTwoStringValues struct {
+	Field1 string
+	Field2 string
+}
+
 type tfSingleFloat64Field struct {
 	Field1 types.Float64 `tfsdk:"field1"`
 }
@@ -484,6 +506,24 @@ type tfJSONStringer struct {
 	Field1 fwtypes.SmithyJSON[smithyjson.JSONStringer] `tfsdk:"field1"`
 }
 
+// testDocumentInterface mimics an AWS SDK for Go v2 service's document.Interface type.
+type testDocumentInterface interface {
+	smithydocument.Marshaler
+	smithydocument.Unmarshaler
+}
+
+func newTestDocument(v any) testDocumentInterface {
+	return &testJSONDocument{Value: v}
+}
+
+type awsDocument struct {
+	Field1 testDocumentInterface
+}
+
+type tfDocument struct {
+	Field1 fwtypes.SmithyJSON[testDocumentInterface] `tfsdk:"field1"`
+}
+
 type tfListNestedObject[T any] struct {
 	Field1 fwtypes.ListNestedObjectValueOf[T] `tfsdk:"field1"`
 }
@@ -702,3 +742,44 @@ type tfSetOfStringEnum struct {
 type awsSliceOfStringEnum struct {
 	Field1 []testEnum
 }
+
+// awsUnion mimics an AWS SDK for Go v2 union type.
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberNestedValue struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberNestedValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberStringValue struct {
+	Value string
+}
+
+func (*awsUnionMemberStringValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+func init() {
+	RegisterUnionMemberTypes[awsUnion](
+		&awsUnionMemberNestedValue{},
+		&awsUnionMemberStringValue{},
+	)
+}
+
+type awsUnionField struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type tfUnion struct {
+	NestedValue fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"nested_value"`
+	StringValue types.String                                         `tfsdk:"string_value"`
+}
+
+type tfUnionField struct {
+	Field1 fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"field1"`
+}
@@ -256,6 +256,20 @@ func traceMatchedFieldsWithPath(sourcePath, sourceFieldName string, sourceType r
 	}
 }
 
+func traceSourceFieldNameOverride(sourceFieldName string, sourceType reflect.Type, targetFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Using source field name override",
+		logAttrKeySourcePath:      "",
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      "",
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
 func debugNoCorrespondingField(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":                  hclog.Debug.String(),
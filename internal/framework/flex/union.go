@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// unions is the registry of AWS SDK for Go v2 union types.
+var unions = struct {
+	sync.RWMutex
+	// members maps a union interface type to its member types.
+	members map[reflect.Type][]reflect.Type
+	// unionTypes maps a union member struct type to its union interface type.
+	unionTypes map[reflect.Type]reflect.Type
+}{
+	members:    make(map[reflect.Type][]reflect.Type),
+	unionTypes: make(map[reflect.Type]reflect.Type),
+}
+
+// RegisterUnionMemberTypes registers the member types of the AWS SDK for Go v2 union interface type T.
+//
+// AutoFlex expands a Terraform nested object with one nested block, or attribute, per union member to T
+// and flattens T to such a nested object.
+// The field corresponding to a union member is the one whose name matches the member type's name without
+// the "<union>Member" prefix, e.g. the `ApiKeyCredential` field for the `CredentialMemberApiKeyCredential` member
+// of the `Credential` union. Expanding uses the non-null field, returning an error if more than one field is non-null,
+// and flattening sets all other fields to null.
+//
+//	func init() {
+//		fwflex.RegisterUnionMemberTypes[awstypes.Credential](
+//			&awstypes.CredentialMemberApiKeyCredential{},
+//			&awstypes.CredentialMemberOauth2Credential{},
+//		)
+//	}
+func RegisterUnionMemberTypes[T any](members ...T) {
+	tUnion := reflect.TypeFor[T]()
+	if tUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("union type %s is not an interface", fullTypeName(tUnion))) //lintignore:R009
+	}
+
+	unions.Lock()
+	defer unions.Unlock()
+
+	for _, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember == nil || tMember.Kind() != reflect.Pointer || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("union %s member type %s is not a pointer to struct", fullTypeName(tUnion), fullTypeName(tMember))) //lintignore:R009
+		}
+
+		tStruct := tMember.Elem()
+		if _, ok := tStruct.FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("union %s member type %s has no %s field", fullTypeName(tUnion), fullTypeName(tMember), unionMemberValueFieldName)) //lintignore:R009
+		}
+
+		if _, ok := unions.unionTypes[tStruct]; ok {
+			continue // Already registered.
+		}
+
+		unions.members[tUnion] = append(unions.members[tUnion], tMember)
+		unions.unionTypes[tStruct] = tUnion
+	}
+}
+
+// unionMemberTypes returns the registered member types of the specified union interface type.
+func unionMemberTypes(tUnion reflect.Type) ([]reflect.Type, bool) {
+	unions.RLock()
+	defer unions.RUnlock()
+
+	members, ok := unions.members[tUnion]
+
+	return members, ok
+}
+
+// unionTypeOf returns the union interface type of the specified union member struct type.
+func unionTypeOf(tStruct reflect.Type) (reflect.Type, bool) {
+	unions.RLock()
+	defer unions.RUnlock()
+
+	tUnion, ok := unions.unionTypes[tStruct]
+
+	return tUnion, ok
+}
+
+// unionMemberName returns the name of a union member, e.g. `ApiKeyCredential` for `CredentialMemberApiKeyCredential`.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	if tMember.Kind() == reflect.Pointer {
+		tMember = tMember.Elem()
+	}
+
+	return strings.TrimPrefix(tMember.Name(), tUnion.Name()+"Member")
+}
+
+// expandUnion copies a Plugin Framework struct with one field per union member to an AWS API union interface value.
+// At most one field may be non-null.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, vTo reflect.Value, tMembers []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+
+	var (
+		fromField  reflect.StructField
+		memberName string
+		tMember    reflect.Type
+		setFields  []string
+	)
+	for _, t := range tMembers {
+		name := unionMemberName(vTo.Type(), t)
+		field, ok := findFieldFuzzy(ctx, name, t.Elem(), typeFrom, flexer)
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+				logAttrKeyTargetFieldname: name,
+			})
+			continue
+		}
+
+		if v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if len(setFields) == 0 {
+			fromField, memberName, tMember = field, name, t
+		}
+		setFields = append(setFields, field.Name)
+	}
+
+	switch len(setFields) {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding null union")
+		return diags
+	case 1:
+	default:
+		diags.Append(diagExpandingMultipleUnionMembers(sourcePath, typeFrom, setFields))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Expanding union member", map[string]any{
+		logAttrKeySourceFieldname: fromField.Name,
+		logAttrKeyTargetFieldname: memberName,
+	})
+
+	to := reflect.New(tMember.Elem())
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(memberName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(to)
+
+	return diags
+}
+
+// flattenUnion copies an AWS API union member struct value to a Plugin Framework struct with one field per union member.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, tUnion reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Set all fields to null.
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeFrom := valFrom.Type()
+	memberName := unionMemberName(tUnion, typeFrom)
+	toField, ok := findFieldFuzzy(ctx, memberName, typeFrom, valTo.Type(), flexer)
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: memberName,
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Flattening union member", map[string]any{
+		logAttrKeySourceFieldname: memberName,
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath, valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
+func diagExpandingMultipleUnionMembers(sourcePath path.Path, sourceType reflect.Type, fieldNames []string) diag.ErrorDiagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		sourcePath,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("At most one union member of %q can be set, got: %s.", fullTypeName(sourceType), strings.Join(fieldNames, ", ")),
+	)
+}
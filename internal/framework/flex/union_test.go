@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestUnionMemberName(t *testing.T) {
+	t.Parallel()
+
+	tUnion := reflect.TypeFor[awsUnion]()
+
+	testCases := map[string]struct {
+		member   any
+		expected string
+	}{
+		"pointer": {
+			member:   &awsUnionMemberStringValue{},
+			expected: "StringValue",
+		},
+		"struct": {
+			member:   awsUnionMemberNestedValue{},
+			expected: "NestedValue",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := unionMemberName(tUnion, reflect.TypeOf(testCase.member)), testCase.expected; got != want {
+				t.Errorf("unionMemberName = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source     any
+		target     any
+		wantErr    bool
+		wantTarget any
+	}{
+		"string member": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					StringValue: types.StringValue("a"),
+				}),
+			},
+			target: &awsUnionField{},
+			wantTarget: &awsUnionField{
+				Field1: &awsUnionMemberStringValue{
+					Value: "a",
+				},
+			},
+		},
+		"nested member": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					NestedValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+					StringValue: types.StringNull(),
+				}),
+			},
+			target: &awsUnionField{},
+			wantTarget: &awsUnionField{
+				Field1: &awsUnionMemberNestedValue{
+					Value: awsSingleStringValue{
+						Field1: "b",
+					},
+				},
+			},
+		},
+		"no member": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					StringValue: types.StringNull(),
+				}),
+			},
+			target:     &awsUnionField{},
+			wantTarget: &awsUnionField{},
+		},
+		"multiple members": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					NestedValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+					StringValue: types.StringValue("a"),
+				}),
+			},
+			target:     &awsUnionField{},
+			wantErr:    true,
+			wantTarget: &awsUnionField{},
+		},
+		"null": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			target:     &awsUnionField{},
+			wantTarget: &awsUnionField{},
+		},
+		"slice": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnion{
+					{
+						NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						StringValue: types.StringValue("a"),
+					},
+					{
+						NestedValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("b"),
+						}),
+						StringValue: types.StringNull(),
+					},
+				}),
+			},
+			target: &awsUnionSlice{},
+			wantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "a",
+					},
+					&awsUnionMemberNestedValue{
+						Value: awsSingleStringValue{
+							Field1: "b",
+						},
+					},
+				},
+			},
+		},
+		"top level": {
+			source: tfUnion{
+				NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				StringValue: types.StringValue("c"),
+			},
+			target: new(awsUnion),
+			wantTarget: func() *awsUnion {
+				var v awsUnion = &awsUnionMemberStringValue{
+					Value: "c",
+				}
+				return &v
+			}(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, testCase.source, testCase.target)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, diags)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.wantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source     any
+		target     any
+		wantTarget any
+	}{
+		"string member": {
+			source: &awsUnionField{
+				Field1: &awsUnionMemberStringValue{
+					Value: "a",
+				},
+			},
+			target: &tfUnionField{},
+			wantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					StringValue: types.StringValue("a"),
+				}),
+			},
+		},
+		"nested member": {
+			source: &awsUnionField{
+				Field1: &awsUnionMemberNestedValue{
+					Value: awsSingleStringValue{
+						Field1: "b",
+					},
+				},
+			},
+			target: &tfUnionField{},
+			wantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					NestedValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+					StringValue: types.StringNull(),
+				}),
+			},
+		},
+		"nil": {
+			source: &awsUnionField{},
+			target: &tfUnionField{},
+			wantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"slice": {
+			source: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "a",
+					},
+					&awsUnionMemberNestedValue{
+						Value: awsSingleStringValue{
+							Field1: "b",
+						},
+					},
+				},
+			},
+			target: &tfUnionField{},
+			wantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnion{
+					{
+						NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						StringValue: types.StringValue("a"),
+					},
+					{
+						NestedValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("b"),
+						}),
+						StringValue: types.StringNull(),
+					},
+				}),
+			},
+		},
+		"top level": {
+			source: &awsUnionMemberStringValue{
+				Value: "c",
+			},
+			target: &tfUnion{},
+			wantTarget: &tfUnion{
+				NestedValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				StringValue: types.StringValue("c"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(ctx, testCase.source, testCase.target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.wantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSmithyDocumentRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	source := &awsDocument{
+		Field1: newTestDocument(map[string]any{
+			"test": "a",
+		}),
+	}
+
+	// The Terraform value's type, e.g. from state, provides the document constructor.
+	tf := &tfDocument{
+		Field1: fwtypes.SmithyJSONValue(`{}`, newTestDocument),
+	}
+	if diags := Flatten(ctx, source, tf); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	target := &awsDocument{}
+	if diags := Expand(ctx, tf, target); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if diff := cmp.Diff(target, source); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
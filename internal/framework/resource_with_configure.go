@@ -28,15 +28,18 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 	}
 }
 
-// SetTagsAll calculates the new value for the `tags_all` attribute.
+// SetTagsAll calculates the new value for the `tags_all` attribute and validates the tags against any provider tag policy.
 func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	// If the entire plan is null, the resource is planned for destruction.
 	if request.Plan.Raw.IsNull() {
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig(ctx)
-	ignoreTagsConfig := r.Meta().IgnoreTagsConfig(ctx)
+	var servicePackageName string
+	if inContext, ok := conns.FromContext(ctx); ok {
+		servicePackageName = inContext.ServicePackageName
+	}
+	pipeline := tftags.NewPipeline(servicePackageName, r.Meta().DefaultTagsConfig(ctx), r.Meta().IgnoreTagsConfig(ctx))
 
 	var planTags tftags.Map
 
@@ -48,8 +51,20 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
-			resourceTags := tftags.New(ctx, planTags)
-			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+			tags := tftags.New(ctx, planTags)
+
+			if policy := r.Meta().TagPolicyConfig(ctx); policy != nil {
+				if err := policy.Validate(pipeline.ResourceTagsIn(tags)); err != nil {
+					if policy.IsWarn() {
+						response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tags do not comply with provider tag_policy", err.Error())
+					} else {
+						response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tags do not comply with provider tag_policy", err.Error())
+						return
+					}
+				}
+			}
+
+			allTags := pipeline.PlannedTagsAll(tags)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
@@ -98,8 +98,17 @@ var (
 	_ basetypes.StringValuable                   = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 	_ basetypes.StringValuableWithSemanticEquals = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 	_ xattr.ValidateableAttribute                = (*SmithyJSON[smithyjson.JSONStringer])(nil)
+	_ SmithyDocumentValuable                     = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 )
 
+// SmithyDocumentValuable is implemented by Smithy document values, whatever their document type.
+type SmithyDocumentValuable interface {
+	basetypes.StringValuable
+
+	// ValueSmithyDocument returns the value as a Smithy document, or nil if the value is null or unknown.
+	ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics)
+}
+
 type SmithyJSON[T smithyjson.JSONStringer] struct {
 	basetypes.StringValue
 	f func(any) T
@@ -123,6 +132,16 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 		return zero, diags
 	}
 
+	if v.f == nil {
+		diags.AddError(
+			"Smithy Document Error",
+			"An unexpected error occurred while creating a Smithy document. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: no document constructor for value of type "+fmt.Sprintf("%T", v),
+		)
+		return zero, diags
+	}
+
 	var data any
 	err := json.Unmarshal([]byte(v.ValueString()), &data)
 
@@ -139,8 +158,27 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 	return v.f(data), diags
 }
 
+func (v SmithyJSON[T]) ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	doc, d := v.ValueInterface()
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return doc, diags
+}
+
 func (v SmithyJSON[T]) Type(context.Context) attr.Type {
-	return SmithyJSONType[T]{}
+	// Preserve the document constructor so that values created from this value's type can also be converted to documents.
+	return SmithyJSONType[T]{
+		f: v.f,
+	}
 }
 
 func (v SmithyJSON[T]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
@@ -150,6 +151,10 @@ func TestSmithyJSONValueInterface(t *testing.T) {
 			val:         fwtypes.SmithyJSONValue[smithyjson.JSONStringer]("not ok", newTestJSONDocument), // lintignore:AWSAT003,AWSAT005
 			expectError: true,
 		},
+		"no document constructor": {
+			val:         fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"test": "value"}`, nil), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -173,3 +178,39 @@ func TestSmithyJSONValueInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestSmithyJSONValueSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Values created from a value's type can be converted to documents.
+	val := fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"test": "value"}`, newTestJSONDocument)
+	v, diags := val.Type(ctx).(basetypes.StringTypable).ValueFromString(ctx, basetypes.NewStringValue(`{"test": "other"}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	doc, diags := v.(fwtypes.SmithyDocumentValuable).ValueSmithyDocument()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := &testJSONDocument{
+		Value: map[string]any{
+			"test": "other",
+		},
+	}
+	if diff := cmp.Diff(doc, smithyjson.JSONStringer(expected)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Null values have no document.
+	doc, diags = fwtypes.SmithyJSONNull[smithyjson.JSONStringer]().ValueSmithyDocument()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if doc != nil {
+		t.Errorf("expected nil document, got %v", doc)
+	}
+}
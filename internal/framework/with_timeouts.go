@@ -37,6 +37,11 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 	w.defaultDeleteTimeout = timeout
 }
 
+// MaxDefaultTimeout returns the longest of the resource's default timeout values.
+func (w *WithTimeouts) MaxDefaultTimeout() time.Duration {
+	return max(w.defaultCreateTimeout, w.defaultReadTimeout, w.defaultUpdateTimeout, w.defaultDeleteTimeout)
+}
+
 // CreateTimeout returns any configured Create timeout value or the default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
 	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
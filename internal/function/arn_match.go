@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	// arnSections is the number of colon-delimited sections in an ARN,
+	// including the leading "arn" literal
+	arnSections = 6
+)
+
+var _ function.Function = arnMatchFunction{}
+
+func NewARNMatchFunction() function.Function {
+	return &arnMatchFunction{}
+}
+
+type arnMatchFunction struct{}
+
+func (f arnMatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_match"
+}
+
+func (f arnMatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_match Function",
+		MarkdownDescription: "Checks whether an ARN matches an IAM-style ARN pattern. Each section of the pattern " +
+			"may contain `*` (any sequence of characters) and `?` (any single character) wildcards.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, optionally containing wildcards",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg, pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := matchARN(arg, pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// matchARN reports whether s matches pattern, comparing each ARN section separately
+// as IAM does when evaluating the Resource element of a policy
+func matchARN(s, pattern string) (bool, error) {
+	if _, err := arn.Parse(s); err != nil {
+		return false, err
+	}
+
+	if pattern == "*" {
+		return true, nil
+	}
+
+	patternSections := strings.SplitN(pattern, ":", arnSections)
+	if len(patternSections) != arnSections || patternSections[0] != "arn" {
+		return false, fmt.Errorf(`pattern must be "*" or of the form "arn:partition:service:region:account-id:resource"`)
+	}
+
+	sections := strings.SplitN(s, ":", arnSections)
+	for i := range arnSections {
+		if !wildcardMatch(patternSections[i], sections[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// wildcardMatch reports whether s matches pattern, where `*` in pattern matches
+// any sequence of characters and `?` matches any single character
+func wildcardMatch(pattern, s string) bool {
+	p, q := []rune(pattern), []rune(s)
+	pi, qi := 0, 0
+	starPi, starQi := -1, -1
+
+	for qi < len(q) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == q[qi]):
+			pi++
+			qi++
+		case pi < len(p) && p[pi] == '*':
+			starPi, starQi = pi, qi
+			pi++
+		case starPi != -1:
+			// Backtrack: let the last `*` consume one more character.
+			starQi++
+			pi, qi = starPi+1, starQi
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// VPC subnet reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// awsReservedIPv4AddressesPerSubnet is the number of IPv4 addresses AWS
+	// reserves in every subnet: the network address, the VPC router, the DNS
+	// server, one for future use and the broadcast address
+	awsReservedIPv4AddressesPerSubnet = 5
+
+	// minIPv4SubnetPrefixLength and maxIPv4SubnetPrefixLength bound the size
+	// of IPv4 VPCs and subnets
+	minIPv4SubnetPrefixLength = 16
+	maxIPv4SubnetPrefixLength = 28
+)
+
+var _ function.Function = cidrUsableHostsFunction{}
+
+func NewCIDRUsableHostsFunction() function.Function {
+	return &cidrUsableHostsFunction{}
+}
+
+type cidrUsableHostsFunction struct{}
+
+func (f cidrUsableHostsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_usable_hosts"
+}
+
+func (f cidrUsableHostsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_usable_hosts Function",
+		MarkdownDescription: "Returns the number of IPv4 addresses available for use in a VPC subnet, " +
+			"excluding the 5 addresses that AWS reserves in every subnet",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 CIDR block of the subnet",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f cidrUsableHostsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := usableHosts(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// usableHosts returns the number of addresses in an IPv4 subnet that are not reserved by AWS
+func usableHosts(cidr string) (int64, error) {
+	prefix, err := parseIPv4SubnetCIDRBlock(cidr)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(1)<<(32-prefix.Bits()) - awsReservedIPv4AddressesPerSubnet, nil
+}
+
+// parseIPv4SubnetCIDRBlock parses an IPv4 CIDR block whose size is valid for a VPC or subnet
+func parseIPv4SubnetCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := verify.ValidateIPv4CIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if bits := prefix.Bits(); bits < minIPv4SubnetPrefixLength || bits > maxIPv4SubnetPrefixLength {
+		return netip.Prefix{}, fmt.Errorf("%q prefix length must be between /%d and /%d", cidr, minIPv4SubnetPrefixLength, maxIPv4SubnetPrefixLength)
+	}
+
+	return prefix, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	// iamPolicyValueListKeys are the statement elements whose values are
+	// unordered lists that may be written as a single string
+	iamPolicyValueListKeys = []string{"Action", "NotAction", "NotResource", "Resource"}
+
+	// iamPolicyPrincipalKeys are the statement elements holding principals
+	iamPolicyPrincipalKeys = []string{"NotPrincipal", "Principal"}
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document. Object keys are sorted with `Version` first, " +
+			"`Statement` is always a list, unordered values are de-duplicated and sorted, single values are collapsed " +
+			"to a string and principals are normalized as by `iam_principal_normalize`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "JSON encoded IAM policy document",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizeIAMPolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizeIAMPolicy returns the canonical JSON encoding of an IAM policy document
+func normalizeIAMPolicy(s string) (string, error) {
+	var policy map[string]any
+	if err := json.Unmarshal([]byte(s), &policy); err != nil {
+		return "", fmt.Errorf("decoding policy: %w", err)
+	}
+
+	if v, ok := policy["Statement"]; ok {
+		var statements []any
+		switch v := v.(type) {
+		case []any:
+			statements = v
+		case map[string]any:
+			statements = []any{v}
+		default:
+			return "", fmt.Errorf("policy Statement must be an object or a list of objects")
+		}
+
+		for i, v := range statements {
+			statement, ok := v.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("policy Statement must be an object or a list of objects")
+			}
+
+			if err := normalizeIAMPolicyStatement(statement); err != nil {
+				return "", fmt.Errorf("policy Statement[%d]: %w", i, err)
+			}
+		}
+
+		policy["Statement"] = statements
+	}
+
+	// Map keys are sorted by json.Marshal.
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	// Move Version to the front, as required by AWS in many places.
+	return verify.LegacyPolicyNormalize(string(b))
+}
+
+func normalizeIAMPolicyStatement(statement map[string]any) error {
+	for _, k := range iamPolicyValueListKeys {
+		if v, ok := statement[k]; ok {
+			statement[k] = normalizeIAMPolicyValues(v)
+		}
+	}
+
+	for _, k := range iamPolicyPrincipalKeys {
+		if v, ok := statement[k]; ok {
+			principal, err := normalizeIAMPrincipalValue(v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+			statement[k] = principal
+		}
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		operators, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("value of Condition must be an object")
+		}
+
+		for operator, v := range operators {
+			keys, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("value of Condition (%s) must be an object", operator)
+			}
+
+			for key, v := range keys {
+				keys[key] = normalizeIAMPolicyValues(v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalizeIAMPolicyValues de-duplicates and sorts a list of strings and collapses
+// a single element list to its element. Other values are returned unchanged
+func normalizeIAMPolicyValues(v any) any {
+	list, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			// Lists of non-string values, e.g. booleans, are left as is.
+			if len(list) == 1 {
+				return list[0]
+			}
+			return list
+		}
+		values = append(values, s)
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamPrincipalNormalizeFunction{}
+
+func NewIAMPrincipalNormalizeFunction() function.Function {
+	return &iamPrincipalNormalizeFunction{}
+}
+
+type iamPrincipalNormalizeFunction struct{}
+
+func (f iamPrincipalNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_principal_normalize"
+}
+
+func (f iamPrincipalNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_principal_normalize Function",
+		MarkdownDescription: "Normalizes the JSON encoding of an IAM policy `Principal` element. Principal values are " +
+			"trimmed, de-duplicated and sorted, single values are collapsed to a string and principal types are sorted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "principal",
+				MarkdownDescription: "JSON encoded IAM policy `Principal` element",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPrincipalNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizeIAMPrincipal(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizeIAMPrincipal returns the canonical JSON encoding of an IAM policy Principal element
+func normalizeIAMPrincipal(s string) (string, error) {
+	var principal any
+	if err := json.Unmarshal([]byte(s), &principal); err != nil {
+		return "", fmt.Errorf("decoding principal: %w", err)
+	}
+
+	normalized, err := normalizeIAMPrincipalValue(principal)
+	if err != nil {
+		return "", err
+	}
+
+	// Map keys are sorted by json.Marshal.
+	b, err := json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func normalizeIAMPrincipalValue(principal any) (any, error) {
+	switch v := principal.(type) {
+	case string:
+		if v = strings.TrimSpace(v); v != "*" {
+			return nil, fmt.Errorf(`principal must be "*" or an object`)
+		}
+		return v, nil
+	case map[string]any:
+		if len(v) == 0 {
+			return nil, fmt.Errorf("principal must not be empty")
+		}
+
+		normalized := make(map[string]any, len(v))
+		for principalType, value := range v {
+			values, err := principalValues(principalType, value)
+			if err != nil {
+				return nil, err
+			}
+
+			slices.Sort(values)
+			values = slices.Compact(values)
+
+			if len(values) == 1 {
+				normalized[principalType] = values[0]
+			} else {
+				normalized[principalType] = values
+			}
+		}
+		return normalized, nil
+	default:
+		return nil, fmt.Errorf(`principal must be "*" or an object`)
+	}
+}
+
+func principalValues(principalType string, value any) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{strings.TrimSpace(v)}, nil
+	case []any:
+		if len(v) == 0 {
+			return nil, fmt.Errorf("principal (%s) must not be empty", principalType)
+		}
+
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("principal (%s) values must be strings", principalType)
+			}
+			values = append(values, strings.TrimSpace(s))
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("principal (%s) must be a string or a list of strings", principalType)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_build Function",
+		MarkdownDescription: "Builds an S3 URI (`s3://bucket/key`) from a bucket name and object key",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Object key or key prefix. May be empty",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket, key string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := buildS3URI(bucket, key)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// buildS3URI joins a bucket and key into an S3 URI
+func buildS3URI(bucket, key string) (string, error) {
+	if bucket == "" {
+		return "", fmt.Errorf("bucket must not be empty")
+	}
+	if strings.Contains(bucket, "/") {
+		return "", fmt.Errorf(`bucket must not contain "/"`)
+	}
+
+	key = strings.TrimPrefix(key, "/")
+	if key == "" {
+		return s3URIScheme + bucket, nil
+	}
+
+	return s3URIScheme + bucket + "/" + key, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Allocates non-overlapping IPv4 subnet CIDR blocks within a VPC CIDR block. " +
+			"For each requested prefix length one subnet is allocated per Availability Zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones",
+			},
+			function.ListParameter{
+				Name:                "sizes",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Prefix lengths of the subnets to allocate in each Availability Zone",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ListType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var azCount int64
+	var sizes []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &azCount, &sizes))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := planVPCSubnets(vpcCIDR, azCount, sizes)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// planVPCSubnets returns, for each of the specified prefix lengths, azCount subnet CIDR blocks
+// packed into the VPC CIDR block without overlap.
+// Subnets are allocated from the start of the VPC CIDR block largest first so that every
+// subnet is aligned to its own size and no address space is wasted between subnets.
+func planVPCSubnets(vpcCIDR string, azCount int64, sizes []int64) ([][]string, error) {
+	vpc, err := parseIPv4SubnetCIDRBlock(vpcCIDR)
+	if err != nil {
+		return nil, err
+	}
+
+	if azCount < 1 {
+		return nil, fmt.Errorf("az_count must be at least 1")
+	}
+
+	// Check that the requested subnets fit before allocating anything.
+	// As subnets are packed without waste they fit if their total size is no larger than the VPC CIDR block.
+	vpcSize := uint64(1) << (32 - vpc.Bits())
+	var sizePerAZ uint64
+	for i, bits := range sizes {
+		if bits < int64(vpc.Bits()) || bits > maxIPv4SubnetPrefixLength {
+			return nil, fmt.Errorf("sizes[%d] (/%d) must be between /%d and /%d", i, bits, vpc.Bits(), maxIPv4SubnetPrefixLength)
+		}
+
+		sizePerAZ += uint64(1) << (32 - bits)
+	}
+
+	if sizePerAZ > vpcSize/uint64(azCount) {
+		return nil, fmt.Errorf("VPC CIDR block %q is too small for the requested subnets", vpcCIDR)
+	}
+
+	type allocation struct {
+		size, az int
+		bits     int
+	}
+
+	var allocations []allocation
+	for i, bits := range sizes {
+		for az := range int(azCount) {
+			allocations = append(allocations, allocation{size: i, az: az, bits: int(bits)})
+		}
+	}
+
+	slices.SortStableFunc(allocations, func(a, b allocation) int {
+		return cmp.Compare(a.bits, b.bits)
+	})
+
+	next := uint64(ipv4ToUint32(vpc.Addr()))
+
+	result := make([][]string, len(sizes))
+	for i := range result {
+		result[i] = make([]string, azCount)
+	}
+
+	for _, a := range allocations {
+		result[a.size][a.az] = netip.PrefixFrom(uint32ToIPv4(uint32(next)), a.bits).String()
+		next += uint64(1) << (32 - a.bits)
+	}
+
+	return result, nil
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func uint32ToIPv4(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetPlanFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 3, "[24, 20]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[["10.0.48.0/24","10.0.49.0/24","10.0.50.0/24"],["10.0.0.0/20","10.0.16.0/20","10.0.32.0/20"]]`),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_full(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/24", 2, "[25]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[["10.0.0.0/25","10.0.0.128/25"]]`),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/24", 2, "[25, 26]"),
+				ExpectError: regexache.MustCompile(`too[\s\n]*small`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_largeAZCount(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", math.MaxInt32, "[28]"),
+				ExpectError: regexache.MustCompile(`too[\s\n]*small`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidSize(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 2, "[29]"),
+				ExpectError: regexache.MustCompile(`sizes\[0\][\s\n]*\(/29\)[\s\n]*must`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidAZCount(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 0, "[24]"),
+				ExpectError: regexache.MustCompile(`az_count[\s\n]*must`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig(vpcCIDR string, azCount int, sizes string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::vpc_subnet_plan(%[1]q, %[2]d, %[3]s))
+}`, vpcCIDR, azCount, sizes)
+}
@@ -348,10 +348,9 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 			}
 		}
 
-		tags := tagsInContext.TagsOut.UnwrapOrDefault()
-
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-		stateTags := flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
+		tags := tagsInContext.Pipeline(inContext.ServicePackageName).DataSourceTags(tagsInContext.TagsOut.UnwrapOrDefault())
+		stateTags := flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Map())
 		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), tftags.NewMapFromMapValue(stateTags))...)
 
 		if diags.HasError() {
@@ -438,16 +437,23 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	region           *types.ServicePackageResourceRegion
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, region *types.ServicePackageResourceRegion) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
+// isRegionOverrideEnabled returns whether the resource has opted in to per-resource Region override.
+func (w *wrappedResource) isRegionOverrideEnabled() bool {
+	return w.region != nil && w.region.IsOverrideEnabled
+}
+
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Metadata(ctx, request, response)
@@ -456,6 +462,10 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled() {
+		addRegionAttribute(response)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -509,6 +519,12 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.isRegionOverrideEnabled() {
+			ctx, request = regionImportState(ctx, request, response, w.meta)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 		v.ImportState(ctx, request, response)
 
 		return
@@ -521,8 +537,16 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.isRegionOverrideEnabled() && w.meta != nil {
+		ctx = regionModifyPlan(ctx, request, response, w.meta)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -592,17 +616,15 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 			return ctx, diags
 		}
 
-		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
-		// Remove system tags.
-		tags = tags.IgnoreSystem(inContext.ServicePackageName)
+		// Merge the resource's configured tags with any provider configured default_tags and remove system tags.
+		tags := tagsInContext.Pipeline(inContext.ServicePackageName).ResourceTagsIn(tftags.New(ctx, planTags))
 
 		tagsInContext.TagsIn = option.Some(tags)
 	case After:
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
 		// Computed tags_all include any provider configured default_tags.
-		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.TagsIn.MustUnwrap().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
+		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.Pipeline(inContext.ServicePackageName).ResourceTagsAll(tagsInContext.TagsIn.MustUnwrap()).Map())
 		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.NewMapFromMapValue(stateTagsAll))...)
 
 		if diags.HasError() {
@@ -698,6 +720,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 			}
 		}
 
+		pipeline := tagsInContext.Pipeline(inContext.ServicePackageName)
 		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
 		// AWS APIs often return empty lists of tags when none have been configured.
@@ -705,7 +728,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 		response.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 		// The resource's configured tags do not include any provider configured default_tags.
-		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).ResolveDuplicatesFramework(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, response, &diags).Map(); len(v) > 0 {
+		if v := pipeline.ResourceTagsFramework(ctx, apiTags, response, &diags).Map(); len(v) > 0 {
 			stateTags = tftags.NewMapFromMapValue(flex.FlattenFrameworkStringValueMapLegacy(ctx, v))
 		}
 		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
@@ -715,7 +738,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 		}
 
 		// Computed tags_all do.
-		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
+		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, pipeline.ResourceTagsAll(apiTags).Map())
 		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.NewMapFromMapValue(stateTagsAll))...)
 
 		if diags.HasError() {
@@ -765,10 +788,8 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 			return ctx, diags
 		}
 
-		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
-		// Remove system tags.
-		tags = tags.IgnoreSystem(inContext.ServicePackageName)
+		// Merge the resource's configured tags with any provider configured default_tags and remove system tags.
+		tags := tagsInContext.Pipeline(inContext.ServicePackageName).ResourceTagsIn(tftags.New(ctx, planTags))
 
 		tagsInContext.TagsIn = option.Some(tags)
 
@@ -59,6 +59,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_organization_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organization IDs. The provider's account must be a member of one of them.",
+			},
+			"allowed_partitions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS partitions. The provider's partition must be one of them.",
+			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Regions. The provider's Region, and any resource's Region, must be one of them.",
+			},
+			"credentials_diagnostics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report the resolved credential source and credentials chain, proactively refresh credentials near expiry and warn when credentials expire before the longest resource operation timeout.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -259,6 +278,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to which no default tags apply. Patterns such as `aws_iam_*` are supported.",
+						},
+						"exclude_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Services, e.g. `iam`, to whose resources no default tags apply.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -266,6 +295,40 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"conditional_tags": schema.ListNestedBlock{
+							Description: "Configuration block with resource tags to default across only the matching resources.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_autoscaling_group`, to which the tags do not apply. Patterns such as `aws_iam_*` are supported.",
+									},
+									"exclude_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services, e.g. `iam`, to whose resources the tags do not apply.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_instance`, to which the tags apply. Patterns such as `aws_iam_*` are supported.",
+									},
+									"include_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services, e.g. `ec2`, to whose resources the tags apply.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the matching resources.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -291,6 +354,115 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_settings": schema.ListNestedBlock{
+				Description: "Configuration block with settings that override the provider-level AWS API client settings for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent in-flight API requests to the service.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an API request to the service is being executed.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum rate of API requests to the service.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries of API requests to the service are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "API error codes, e.g. `ConcurrentModificationException`, that are retried in addition to the AWS SDK's defaults.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`, named as in the `endpoints` configuration block.",
+						},
+						"timeout": schema.StringAttribute{
+							Optional:    true,
+							Description: "The time limit, e.g. `30s`, for each API request to the service.",
+						},
+						"token_bucket_rate_limiter_capacity": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The capacity of the AWS SDK's token bucket rate limiter for API requests to the service.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"operation": schema.ListNestedBlock{
+							Description: "Configuration block with client-side limits on API requests for an operation of the service.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"max_concurrent_requests": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The maximum number of concurrent in-flight API requests for the operation.",
+									},
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "The API operation name, e.g. `ChangeResourceRecordSets`.",
+									},
+									"requests_per_second": schema.Float64Attribute{
+										Optional:    true,
+										Description: "The maximum rate of API requests for the operation.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a policy that resource tags are validated against at plan time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that all resource tag keys must have. Valid values are `lower` and `upper`.",
+						},
+						"mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "How policy violations are reported. Valid values are `error` (the default), which fails the plan, and `warn`.",
+						},
+						"use_organizations_policy": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to also enforce the rules of the account's AWS Organizations effective tag policy.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration block with a rule for a resource tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Allowed values of the resource tag. A value ending in `*` allows any value with that prefix.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key. Resource tag keys that only differ in case are violations.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether all resources must have the resource tag.",
+									},
+									"value_regex": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that the value of the resource tag must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -336,7 +508,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, typeName, v.Name)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -407,7 +579,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, typeName, v.Name)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -418,6 +590,21 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			if v := v.Region; v != nil && v.IsOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				// Run first so that other interceptors make AWS API calls in the resource's Region.
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -447,7 +634,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, v.Region)
 			})
 		}
 	}
@@ -486,9 +673,13 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					continue
 				}
 
+				metadataResponse := ephemeral.MetadataResponse{}
+				inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+				typeName := metadataResponse.TypeName
+
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, typeName, v.Name)
 					if meta != nil {
 						ctx = meta.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
@@ -520,7 +711,17 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRUsableHostsFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewIAMPrincipalNormalizeFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
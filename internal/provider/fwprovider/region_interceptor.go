@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionAttribute returns the top-level `region` argument added to the schema of a resource
+// that has opted in to per-resource Region override.
+func regionAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// addRegionAttribute adds the top-level `region` argument to a resource's schema.
+func addRegionAttribute(response *resource.SchemaResponse) {
+	if _, ok := response.Schema.Attributes[names.AttrRegion]; ok {
+		response.Diagnostics.AddError("Invalid resource schema", fmt.Sprintf("`%s` attribute already defined in schema", names.AttrRegion))
+
+		return
+	}
+
+	response.Schema.Attributes[names.AttrRegion] = regionAttribute()
+}
+
+// regionFrom returns the resource's Region from the specified plan or state.
+// Resources created before the `region` argument was added have none in state and are in the provider's Region.
+func regionFrom(ctx context.Context, s interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	var region types.String
+	diags.Append(s.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		if err := meta.ValidateRegion(ctx, v); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+
+			return ctx, diags
+		}
+
+		ctx = conns.NewRegionContext(ctx, v)
+	}
+
+	return ctx, diags
+}
+
+// setRegionIn sets the resource's Region in the specified state.
+func setRegionIn(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+
+	return diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = regionFrom(ctx, request.Plan, meta, diags)
+	case After:
+		diags = setRegionIn(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = regionFrom(ctx, request.State, meta, diags)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags = setRegionIn(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = regionFrom(ctx, request.Plan, meta, diags)
+	case After:
+		diags = setRegionIn(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = regionFrom(ctx, request.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// regionModifyPlan plans the resource's Region.
+// If no `region` is configured the provider's configured Region is used, so that changing the provider's Region
+// replaces the resource.
+// The returned Context is used to make any of the resource's AWS API calls during planning in the resource's Region.
+func regionModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) context.Context {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return ctx
+	}
+
+	var configRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if configRegion.IsUnknown() {
+		return ctx
+	}
+
+	if !configRegion.IsNull() {
+		ctx, response.Diagnostics = regionFrom(ctx, request.Config, meta, response.Diagnostics)
+
+		return ctx
+	}
+
+	region := meta.Region
+
+	if !request.State.Raw.IsNull() {
+		var stateRegion types.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if response.Diagnostics.HasError() {
+			return ctx
+		}
+
+		// Resources created before the `region` argument was added are in the provider's Region.
+		if v := stateRegion.ValueString(); v != "" && v != region {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+	return conns.NewRegionContext(ctx, region)
+}
+
+// regionImportState accepts an import ID with an optional "@<region>" suffix.
+// The returned request's ID has any Region suffix removed.
+func regionImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient) (context.Context, resource.ImportStateRequest) {
+	id, region := conns.SplitImportIDRegion(request.ID)
+	if region == "" {
+		return ctx, request
+	}
+
+	if err := meta.ValidateRegion(ctx, region); err != nil {
+		response.Diagnostics.AddError("Invalid import ID", err.Error())
+
+		return ctx, request
+	}
+
+	request.ID = id
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+	return conns.NewRegionContext(ctx, region), request
+}
@@ -233,18 +233,25 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	if !ok {
 		return ctx, diags
 	}
+	pipeline := tagsInContext.Pipeline(inContext.ServicePackageName)
 
 	switch when {
 	case Before:
 		switch why {
 		case Create, Update:
-			// Merge the resource's configured tags with any provider configured default_tags.
-			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
-			// Remove system tags.
-			tags = tags.IgnoreSystem(inContext.ServicePackageName)
+			// Merge the resource's configured tags with any provider configured default_tags and remove system tags.
+			tags := pipeline.ResourceTagsIn(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
 
 			tagsInContext.TagsIn = option.Some(tags)
 
+			// Plugin SDK v2 CustomizeDiff functions can't return warning diagnostics,
+			// so tag policy violations in warn mode are reported when the resource is created or updated.
+			if policy := meta.(*conns.AWSClient).TagPolicyConfig(ctx); policy.IsWarn() {
+				if err := policy.Validate(tags); err != nil {
+					diags = sdkdiag.AppendWarningf(diags, "tags do not comply with provider tag_policy: %s", err)
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -356,16 +363,16 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 				}
 			}
 
-			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+			apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
-			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, names.AttrTags, nil).Map()); err != nil {
+			if err := d.Set(names.AttrTags, pipeline.ResourceTags(ctx, apiTags, d, names.AttrTags).Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 			}
 
 			// Computed tags_all do.
-			if err := d.Set(names.AttrTagsAll, tags.Map()); err != nil {
+			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
+			if err := d.Set(names.AttrTagsAll, pipeline.ResourceTagsAll(apiTags).Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTagsAll, err)
 			}
 		}
@@ -416,6 +423,7 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 	if !ok {
 		return ctx, diags
 	}
+	pipeline := tagsInContext.Pipeline(inContext.ServicePackageName)
 
 	switch when {
 	case Before:
@@ -486,7 +494,7 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 			}
 
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+			tags := pipeline.DataSourceTags(tagsInContext.TagsOut.UnwrapOrDefault())
 			if err := d.Set(names.AttrTags, tags.Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 			}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -52,8 +53,32 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organization IDs. The provider's account must be a member of one of them.",
+			},
+			"allowed_partitions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS partitions. The provider's partition must be one of them.",
+			},
+			"allowed_regions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Regions. The provider's Region, and any resource's Region, must be one of them.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"credentials_diagnostics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Report the resolved credential source and credentials chain, " +
+					"proactively refresh credentials near expiry and warn when credentials expire before the longest resource operation timeout.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -68,6 +93,57 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"conditional_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with resource tags to default across only the matching resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, e.g. `aws_autoscaling_group`, to which the tags do not apply. Patterns such as `aws_iam_*` are supported.",
+									},
+									"exclude_services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Services, e.g. `iam`, to whose resources the tags do not apply.",
+									},
+									"include_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, e.g. `aws_instance`, to which the tags apply. Patterns such as `aws_iam_*` are supported.",
+									},
+									"include_services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Services, e.g. `ec2`, to whose resources the tags apply.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across the matching resources.",
+									},
+								},
+							},
+						},
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to which no default tags apply. Patterns such as `aws_iam_*` are supported.",
+						},
+						"exclude_services": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Services, e.g. `iam`, to whose resources no default tags apply.",
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -191,6 +267,86 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_settings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings that override the provider-level AWS API client settings for a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_requests": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of concurrent in-flight API requests to the service.",
+						},
+						"max_retries": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of times an API request to the service is being executed.",
+						},
+						"operation": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with client-side limits on API requests for an operation of the service.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_concurrent_requests": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+										Description:  "The maximum number of concurrent in-flight API requests for the operation.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The API operation name, e.g. `ChangeResourceRecordSets`.",
+									},
+									"requests_per_second": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0),
+										Description:  "The maximum rate of API requests for the operation.",
+									},
+								},
+							},
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "The maximum rate of API requests to the service.",
+						},
+						"retry_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(aws.RetryModeStandard, aws.RetryModeAdaptive), false),
+							Description:  "Specifies how retries of API requests to the service are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "API error codes, e.g. `ConcurrentModificationException`, that are retried in addition to the AWS SDK's defaults.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, e.g. `route53`, named as in the `endpoints` configuration block.",
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "The time limit, e.g. `30s`, for each API request to the service.",
+						},
+						"token_bucket_rate_limiter_capacity": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The capacity of the AWS SDK's token bucket rate limiter for API requests to the service.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -234,6 +390,64 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a policy that resource tags are validated against at plan time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyKeyCases(), false),
+							Description:  "Case that all resource tag keys must have. Valid values are `lower` and `upper`.",
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyModes(), false),
+							Description:  "How policy violations are reported. Valid values are `error` (the default), which fails the plan, and `warn`.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with a rule for a resource tag key.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Allowed values of the resource tag. A value ending in `*` allows any value with that prefix.",
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key. Resource tag keys that only differ in case are violations.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether all resources must have the resource tag.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the value of the resource tag must match.",
+									},
+								},
+							},
+						},
+						"use_organizations_policy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to also enforce the rules of the account's AWS Organizations effective tag policy.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -264,8 +478,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
+	// The longest default operation timeout of the provider's resources.
+	var maxResourceTimeout time.Duration
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		return configure(ctx, provider, d, maxResourceTimeout)
 	}
 
 	var errs []error
@@ -275,6 +492,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		servicePackageName := sp.ServicePackageName()
 		servicePackageMap[servicePackageName] = sp
 
+		for _, v := range sp.FrameworkResources(ctx) {
+			if r, err := v.Factory(ctx); err == nil {
+				if v, ok := r.(interface{ MaxDefaultTimeout() time.Duration }); ok {
+					maxResourceTimeout = max(maxResourceTimeout, v.MaxDefaultTimeout())
+				}
+			}
+		}
+
 		for _, v := range sp.SDKDataSources(ctx) {
 			typeName := v.TypeName
 
@@ -285,6 +510,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			r := v.Factory()
 
+			if v := r.Timeouts; v != nil {
+				for _, v := range []*time.Duration{v.Create, v.Read, v.Update, v.Delete, v.Default} {
+					if v != nil {
+						maxResourceTimeout = max(maxResourceTimeout, *v)
+					}
+				}
+			}
+
 			// Ensure that the correct CRUD handler variants are used.
 			if r.Read != nil || r.ReadContext != nil {
 				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s", typeName))
@@ -293,7 +526,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, typeName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -349,6 +582,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			r := v.Factory()
 
+			if v := r.Timeouts; v != nil {
+				for _, v := range []*time.Duration{v.Create, v.Read, v.Update, v.Delete, v.Default} {
+					if v != nil {
+						maxResourceTimeout = max(maxResourceTimeout, *v)
+					}
+				}
+			}
+
 			// Ensure that the correct CRUD handler variants are used.
 			if r.Create != nil || r.CreateContext != nil {
 				errs = append(errs, fmt.Errorf("incorrect Create handler variant: %s", typeName))
@@ -369,7 +610,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, typeName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -379,6 +620,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if v := v.Region; v != nil && v.IsOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				if err := addRegionAttribute(r); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				// Run first so that other interceptors make AWS API calls in the resource's Region.
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionResourceInterceptor{},
+				})
+
+				r.CustomizeDiff = regionCustomizeDiff(r.CustomizeDiff)
+				if v := r.Importer; v != nil && v.StateContext != nil {
+					// Don't modify an importer that may be shared across provider instances.
+					importer := *v
+					importer.StateContext = regionImporter(v.StateContext)
+					r.Importer = &importer
+				}
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -469,7 +733,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 }
 
 // configure ensures that the provider is fully configured.
-func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
+// maxResourceTimeout is the longest default operation timeout of the provider's resources.
+func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData, maxResourceTimeout time.Duration) (*conns.AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	terraformVersion := provider.TerraformVersion
@@ -481,11 +746,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		CredentialsDiagnostics:         d.Get("credentials_diagnostics").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
+		MaxResourceTimeout:             maxResourceTimeout,
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
@@ -518,6 +785,18 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationIDs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_partitions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedPartitions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -598,10 +877,28 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicy, dx := expandTagPolicy(v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicy
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_settings"); ok && len(v.([]interface{})) > 0 {
+		serviceSettings, dx := expandServiceSettings(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceSettings = serviceSettings
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -885,13 +1182,192 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		}
 	}
 
+	config := &tftags.DefaultConfig{
+		Exclude: expandDefaultTagsMatcher(tfMap, "exclude_"),
+	}
+
 	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+		config.Tags = tftags.New(ctx, tags)
+	}
+
+	if v, ok := tfMap["conditional_tags"].([]interface{}); ok {
+		for _, v := range v {
+			tfMap, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			tags, ok := tfMap["tags"].(map[string]interface{})
+			if !ok || len(tags) == 0 {
+				continue
+			}
+
+			config.Conditional = append(config.Conditional, tftags.ConditionalDefaultTags{
+				Tags:    tftags.New(ctx, tags),
+				Include: expandDefaultTagsMatcher(tfMap, "include_"),
+				Exclude: expandDefaultTagsMatcher(tfMap, "exclude_"),
+			})
+		}
+	}
+
+	if len(config.Tags) == 0 && len(config.Conditional) == 0 {
+		return nil
+	}
+
+	return config
+}
+
+func expandDefaultTagsMatcher(tfMap map[string]interface{}, prefix string) tftags.DefaultTagsMatcher {
+	var matcher tftags.DefaultTagsMatcher
+
+	if v, ok := tfMap[prefix+"resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		matcher.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[prefix+"services"].(*schema.Set); ok && v.Len() > 0 {
+		matcher.Services = flex.ExpandStringValueSet(v)
+	}
+
+	return matcher
+}
+
+func expandServiceSettings(_ context.Context, tfList []interface{}) (map[string]conns.ServiceSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("service_settings")
+	serviceSettings := make(map[string]conns.ServiceSettings)
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+
+		servicePackageName, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", err.Error()))
+			continue
+		}
+
+		if _, ok := serviceSettings[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value",
+				fmt.Sprintf("Settings for service %q are configured more than once.", servicePackageName)))
+			continue
+		}
+
+		settings := conns.ServiceSettings{
+			Limits: expandAPILimits(tfMap),
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok {
+			settings.MaxRetries = v
+		}
+
+		if v, ok := tfMap["operation"].([]interface{}); ok && len(v) > 0 {
+			settings.OperationLimits = make(map[string]conns.APILimits)
+
+			for _, v := range v {
+				tfMap, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				settings.OperationLimits[tfMap[names.AttrName].(string)] = expandAPILimits(tfMap)
+			}
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			settings.RetryMode = aws.RetryMode(v)
 		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			settings.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["timeout"].(string); ok && v != "" {
+			// Validated by the schema.
+			settings.Timeout, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["token_bucket_rate_limiter_capacity"].(int); ok {
+			settings.TokenBucketRateLimiterCapacity = v
+		}
+
+		serviceSettings[servicePackageName] = settings
+	}
+
+	return serviceSettings, diags
+}
+
+func expandAPILimits(tfMap map[string]interface{}) conns.APILimits {
+	var limits conns.APILimits
+
+	if v, ok := tfMap["max_concurrent_requests"].(int); ok {
+		limits.MaxConcurrentRequests = v
+	}
+
+	if v, ok := tfMap["requests_per_second"].(float64); ok {
+		limits.RequestsPerSecond = v
+	}
+
+	return limits
+}
+
+func expandTagPolicy(tfMap map[string]interface{}) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("rule")
+	config := &tftags.PolicyConfig{
+		Mode: tftags.PolicyModeError,
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		config.KeyCase = tftags.PolicyKeyCase(v)
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		config.Mode = tftags.PolicyMode(v)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for i, v := range v {
+			tfMap, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfMap["key"].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["required"].(bool); ok {
+				rule.Required = v
+			}
+
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				// The value isn't validated by the schema if it's unknown during validation.
+				if _, es := validation.StringIsValidRegExp(v, "value_regex"); len(es) > 0 {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(path.IndexInt(i).GetAttr("value_regex"), "Invalid Attribute Value", errors.Join(es...).Error()))
+					continue
+				}
+				rule.ValueRegex = regexache.MustCompile(v)
+			}
+
+			config.Rules = append(config.Rules, rule)
+		}
+	}
+
+	if v, ok := tfMap["use_organizations_policy"].(bool); ok {
+		config.UseOrganizationsPolicy = v
 	}
 
-	return nil
+	return config, diags
 }
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
@@ -8,11 +8,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -307,6 +310,195 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTagsConditional(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results := expandDefaultTags(ctx, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Owner": "my-team",
+		},
+		"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_ssm_parameter"}),
+		"conditional_tags": []interface{}{
+			map[string]interface{}{
+				"tags": map[string]interface{}{
+					"CostCenter": "1234",
+				},
+				"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_autoscaling_group"}),
+				"exclude_services":       schema.NewSet(schema.HashString, []interface{}{"iam"}),
+				"include_resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+				"include_services":       schema.NewSet(schema.HashString, []interface{}{}),
+			},
+		},
+	})
+
+	if results == nil {
+		t.Fatal("Expected default tags config, got nil")
+	}
+
+	for _, testcase := range []struct {
+		servicePackageName string
+		typeName           string
+		expected           map[string]string
+	}{
+		{"ec2", "aws_vpc", map[string]string{"CostCenter": "1234", "Owner": "my-team"}},
+		{"autoscaling", "aws_autoscaling_group", map[string]string{"Owner": "my-team"}},
+		{"iam", "aws_iam_role", map[string]string{"Owner": "my-team"}},
+		{"ssm", "aws_ssm_parameter", nil},
+	} {
+		got := results.ForResource(testcase.servicePackageName, testcase.typeName)
+
+		if testcase.expected == nil {
+			if got != nil {
+				t.Errorf("%s: Expected no default tags, got %v", testcase.typeName, got.Tags.Map())
+			}
+			continue
+		}
+
+		if got == nil || !got.TagsEqual(tftags.New(ctx, testcase.expected)) {
+			t.Errorf("%s: Expected default tags %v, got %v", testcase.typeName, testcase.expected, got)
+		}
+	}
+}
+
+func TestExpandServiceSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandServiceSettings(ctx, []interface{}{
+		map[string]interface{}{
+			"max_concurrent_requests": 5,
+			"max_retries":             10,
+			"operation": []interface{}{
+				map[string]interface{}{
+					"max_concurrent_requests": 1,
+					"name":                    "ChangeResourceRecordSets",
+					"requests_per_second":     float64(0),
+				},
+			},
+			"requests_per_second":                2.5,
+			"retry_mode":                         "adaptive",
+			"retryable_error_codes":              schema.NewSet(schema.HashString, []interface{}{"PriorRequestNotComplete"}),
+			"service":                            "route53",
+			"timeout":                            "1m",
+			"token_bucket_rate_limiter_capacity": 0,
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := map[string]conns.ServiceSettings{
+		names.Route53: {
+			Limits: conns.APILimits{
+				MaxConcurrentRequests: 5,
+				RequestsPerSecond:     2.5,
+			},
+			MaxRetries: 10,
+			OperationLimits: map[string]conns.APILimits{
+				"ChangeResourceRecordSets": {
+					MaxConcurrentRequests: 1,
+				},
+			},
+			RetryableErrorCodes: []string{"PriorRequestNotComplete"},
+			RetryMode:           aws.RetryModeAdaptive,
+			Timeout:             time.Minute,
+		},
+	}
+	if diff := cmp.Diff(results, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	_, diags = expandServiceSettings(ctx, []interface{}{
+		map[string]interface{}{"service": "route53"},
+		map[string]interface{}{"service": "route53"},
+		map[string]interface{}{"service": "not-a-service"},
+	})
+
+	if got, want := len(diags), 2; got != want {
+		t.Errorf("%d diagnostics, want %d: %v", got, want, diags)
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandTagPolicy(map[string]interface{}{
+		"key_case": "",
+		"mode":     "",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"allowed_values": schema.NewSet(schema.HashString, []interface{}{"dev", "prod"}),
+				"key":            "Environment",
+				"required":       true,
+				"value_regex":    "",
+			},
+			map[string]interface{}{
+				"allowed_values": schema.NewSet(schema.HashString, []interface{}{}),
+				"key":            "CostCenter",
+				"required":       false,
+				"value_regex":    `^\d{4}$`,
+			},
+		},
+		"use_organizations_policy": false,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := results.Mode, tftags.PolicyModeError; got != want {
+		t.Errorf("Mode = %s, want %s", got, want)
+	}
+
+	if got, want := len(results.Rules), 2; got != want {
+		t.Fatalf("len(Rules) = %d, want %d", got, want)
+	}
+
+	for _, testcase := range []struct {
+		tags    map[string]string
+		wantErr bool
+	}{
+		{map[string]string{"Environment": "dev", "CostCenter": "1234"}, false},
+		{map[string]string{"Environment": "prod"}, false},
+		{map[string]string{"CostCenter": "1234"}, true},
+		{map[string]string{"Environment": "test"}, true},
+		{map[string]string{"Environment": "dev", "CostCenter": "cc-1234"}, true},
+	} {
+		err := results.Validate(tftags.New(ctx, testcase.tags))
+
+		if got := err != nil; got != testcase.wantErr {
+			t.Errorf("%v: Expected error %t, got %v", testcase.tags, testcase.wantErr, err)
+		}
+	}
+}
+
+func TestExpandTagPolicy_invalidValueRegex(t *testing.T) {
+	t.Parallel()
+
+	_, diags := expandTagPolicy(map[string]interface{}{
+		"rule": []interface{}{
+			map[string]interface{}{
+				"key":         "Environment",
+				"value_regex": `^(dev|prod$`,
+			},
+		},
+	})
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("%d diagnostics, want %d: %v", got, want, diags)
+	}
+
+	if got, want := diags[0].AttributePath, cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("rule").IndexInt(0).GetAttr("value_regex"); !got.Equals(want) {
+		t.Errorf("AttributePath = %#v, want %#v", got, want)
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
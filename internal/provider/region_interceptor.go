@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// addRegionAttribute adds the top-level `region` argument to the schema of a resource
+// that has opted in to per-resource Region override.
+func addRegionAttribute(r *schema.Resource) error {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return fmt.Errorf("`%s` attribute already defined in schema", names.AttrRegion)
+	}
+
+	attribute := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+
+	// Resources may return a schema map shared across provider instances (e.g. a package-level variable),
+	// so never modify the map in place.
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			return withSchemaAttribute(f(), names.AttrRegion, attribute)
+		}
+	} else {
+		r.Schema = withSchemaAttribute(r.Schema, names.AttrRegion, attribute)
+	}
+
+	return nil
+}
+
+// withSchemaAttribute returns a copy of the specified schema map with the specified attribute added.
+func withSchemaAttribute(s map[string]*schema.Schema, name string, attribute *schema.Schema) map[string]*schema.Schema {
+	s = maps.Clone(s)
+	if s == nil {
+		s = make(map[string]*schema.Schema)
+	}
+	s[name] = attribute
+
+	return s
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c := meta.(*conns.AWSClient)
+
+	switch when {
+	case Before:
+		// Make the resource's AWS API calls in the resource's Region.
+		// Resources created before the `region` argument was added have none in state and are in the provider's Region.
+		if region := d.Get(names.AttrRegion).(string); region != "" {
+			if err := c.ValidateRegion(ctx, region); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			ctx = conns.NewRegionContext(ctx, region)
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, c.EffectiveRegion(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff returns a CustomizeDiff function that plans the resource's Region and then
+// calls any CustomizeDiff function defined by the resource, making AWS API calls in the resource's Region.
+// If no `region` is configured the provider's configured Region is used, so that changing the provider's Region
+// replaces the resource.
+func regionCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c := meta.(*conns.AWSClient)
+		region := d.Get(names.AttrRegion).(string)
+
+		if d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+			// Resources created before the `region` argument was added have their Region set on the next Read.
+			if o, _ := d.GetChange(names.AttrRegion); region != c.Region && (d.Id() == "" || o.(string) != "") {
+				if err := d.SetNew(names.AttrRegion, c.Region); err != nil {
+					return err
+				}
+
+				region = c.Region
+			}
+		} else if region != "" {
+			if err := c.ValidateRegion(ctx, region); err != nil {
+				return err
+			}
+		}
+
+		if region != "" {
+			ctx = conns.NewRegionContext(ctx, region)
+		}
+
+		if f != nil {
+			return f(ctx, d, meta)
+		}
+
+		return nil
+	}
+}
+
+// regionImporter returns a StateContext function that accepts an import ID with an optional "@<region>" suffix.
+func regionImporter(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region := conns.SplitImportIDRegion(d.Id()); region != "" {
+			if err := meta.(*conns.AWSClient).ValidateRegion(ctx, region); err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+
+			ctx = conns.NewRegionContext(ctx, region)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
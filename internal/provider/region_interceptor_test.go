@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAddRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource    *schema.Resource
+		expectError bool
+	}{
+		"Schema": {
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"SchemaFunc": {
+			resource: &schema.Resource{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					}
+				},
+			},
+		},
+		"existing region attribute": {
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrRegion: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := addRegionAttribute(testCase.resource)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			v, ok := testCase.resource.SchemaMap()[names.AttrRegion]
+			if !ok {
+				t.Fatalf("no %s attribute", names.AttrRegion)
+			}
+			if !v.Optional || !v.Computed || !v.ForceNew {
+				t.Errorf("%s attribute must be Optional, Computed and ForceNew", names.AttrRegion)
+			}
+			if _, ok := testCase.resource.SchemaMap()[names.AttrName]; !ok {
+				t.Errorf("no %s attribute", names.AttrName)
+			}
+		})
+	}
+}
+
+func TestAddRegionAttribute_sharedSchema(t *testing.T) {
+	t.Parallel()
+
+	sharedSchema := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	for _, resource := range []*schema.Resource{
+		{Schema: sharedSchema},
+		{SchemaFunc: func() map[string]*schema.Schema { return sharedSchema }},
+	} {
+		if err := addRegionAttribute(resource); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := resource.SchemaMap()[names.AttrRegion]; !ok {
+			t.Errorf("no %s attribute", names.AttrRegion)
+		}
+	}
+
+	if _, ok := sharedSchema[names.AttrRegion]; ok {
+		t.Errorf("shared schema modified: %s attribute added", names.AttrRegion)
+	}
+}
+
+func TestProviderNew_multiple(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for range 2 {
+		if _, err := New(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
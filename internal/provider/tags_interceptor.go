@@ -68,10 +68,8 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 
 	oldTags := tftags.New(ctx, stateTags)
 	// if tags_all was computed because not wholly known
-	// Merge the resource's configured tags with any provider configured default_tags.
-	newTags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
-	// Remove system tags.
-	newTags = newTags.IgnoreSystem(inContext.ServicePackageName)
+	// Merge the resource's configured tags with any provider configured default_tags and remove system tags.
+	newTags := tagsInContext.Pipeline(inContext.ServicePackageName).ResourceTagsIn(tftags.New(ctx, configTags))
 
 	// If the service package has a generic resource update tags methods, call it.
 	var err error
@@ -155,16 +153,17 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 		}
 	}
 
-	// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-	toAdd := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+	pipeline := tagsInContext.Pipeline(inContext.ServicePackageName)
+	apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
 	// The resource's configured tags can now include duplicate tags that have been configured on the provider.
-	if err := d.Set(names.AttrTags, toAdd.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, names.AttrTags, nil).Map()); err != nil {
+	if err := d.Set(names.AttrTags, pipeline.ResourceTags(ctx, apiTags, d, names.AttrTags).Map()); err != nil {
 		return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 	}
 
 	// Computed tags_all do.
-	if err := d.Set(names.AttrTagsAll, toAdd.Map()); err != nil {
+	// Remove any provider configured ignore_tags and system tags from those returned from the service API.
+	if err := d.Set(names.AttrTagsAll, pipeline.ResourceTagsAll(apiTags).Map()); err != nil {
 		return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTagsAll, err)
 	}
 
@@ -14,6 +14,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockService struct{}
@@ -92,7 +93,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "Test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -112,6 +113,76 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptor_tagPolicyWarn(t *testing.T) {
+	t.Parallel()
+
+	tags := tagsResourceInterceptor{
+		tags: &types.ServicePackageResourceTags{
+			IdentifierAttribute: "id",
+		},
+		updateFunc: tagsUpdateFunc,
+		readFunc:   tagsReadFunc,
+	}
+
+	testCases := map[string]struct {
+		policy    *tftags.PolicyConfig
+		wantDiags int
+	}{
+		"no policy": {
+			wantDiags: 0,
+		},
+		"error mode": {
+			policy: &tftags.PolicyConfig{
+				Mode:  tftags.PolicyModeError,
+				Rules: []tftags.PolicyRule{{Key: "Owner", Required: true}},
+			},
+			wantDiags: 0,
+		},
+		"warn mode compliant": {
+			policy: &tftags.PolicyConfig{
+				Mode:  tftags.PolicyModeWarn,
+				Rules: []tftags.PolicyRule{{Key: "tag1", Required: true}},
+			},
+			wantDiags: 0,
+		},
+		"warn mode noncompliant": {
+			policy: &tftags.PolicyConfig{
+				Mode:  tftags.PolicyModeWarn,
+				Rules: []tftags.PolicyRule{{Key: "Owner", Required: true}},
+			},
+			wantDiags: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+			}
+			conns.SetTagPolicyConfig(conn, testCase.policy)
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test", "Test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig(ctx), conn.IgnoreTagsConfig(ctx))
+
+			var diags diag.Diagnostics
+			_, diags = tags.run(ctx, &taggedResourceData{}, conn, Before, Create, diags)
+
+			if got, want := len(diags), testCase.wantDiags; got != want {
+				t.Fatalf("length of diags = %v, want %v", got, want)
+			}
+			if len(diags) > 0 {
+				if got, want := diags[0].Severity, diag.Warning; got != want {
+					t.Errorf("severity = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -153,3 +224,18 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+// taggedResourceData is a resourceData with configured tags.
+type taggedResourceData struct {
+	resourceData
+}
+
+func (d *taggedResourceData) Get(key string) any {
+	if key == names.AttrTags {
+		return map[string]interface{}{
+			"tag1": "value1",
+		}
+	}
+
+	return nil
+}
@@ -9,3 +9,16 @@ var (
 
 	FindResource = findResource
 )
+
+type ResourceCondition = resourceCondition
+
+func NewResourceCondition(expression string, expectedValue *string) ResourceCondition {
+	return resourceCondition{
+		expression:    expression,
+		expectedValue: expectedValue,
+	}
+}
+
+func (c ResourceCondition) Holds(properties string) (bool, error) {
+	return c.holds(properties)
+}
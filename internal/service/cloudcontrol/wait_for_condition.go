@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/jmespath/go-jmespath"
+)
+
+const (
+	defaultWaitForConditionPollInterval = 10 * time.Second
+)
+
+// @FrameworkResource("aws_cloudcontrolapi_wait_for_condition", name="Wait For Condition")
+func newWaitForConditionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &waitForConditionResource{}
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+// waitForConditionResource waits for a condition on a Cloud Control API resource's properties.
+// Only the properties returned by the Cloud Control API GetResource operation are supported,
+// not service-specific states such as a Route 53 change's status or an ECS service's steady state.
+type waitForConditionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*waitForConditionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudcontrolapi_wait_for_condition"
+}
+
+func (r *waitForConditionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCondition: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					jmespathExpressionValidator{},
+				},
+				Description: "JMESPath expression evaluated against the resource's properties.",
+			},
+			"expected_value": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Value that the condition must evaluate to. If not set, the condition must evaluate to a truthy value.",
+			},
+			"identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				CustomType: timetypes.GoDurationType{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "How often the resource is read while waiting. Defaults to 10s.",
+			},
+			names.AttrProperties: schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Computed:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary map of values that, when changed, cause the wait to be repeated.",
+			},
+			"type_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
+			},
+			"type_version_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *waitForConditionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data waitForConditionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudControlClient(ctx)
+
+	pollInterval := defaultWaitForConditionPollInterval
+	if !data.PollInterval.IsNull() {
+		v, d := data.PollInterval.ValueGoDuration()
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		pollInterval = v
+	}
+
+	condition := resourceCondition{
+		expression: data.Condition.ValueString(),
+	}
+	if !data.ExpectedValue.IsNull() {
+		condition.expectedValue = data.ExpectedValue.ValueStringPointer()
+	}
+
+	identifier, typeName := data.Identifier.ValueString(), data.TypeName.ValueString()
+	properties, err := waitResourceCondition(ctx, conn, identifier, typeName, data.TypeVersionID.ValueString(), data.RoleARN.ValueString(), condition, r.CreateTimeout(ctx, data.Timeouts), pollInterval)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) resource (%s) condition (%s)", typeName, identifier, condition.expression), err.Error())
+
+		return
+	}
+
+	data.Properties = jsontypes.NewNormalizedValue(properties)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+type waitForConditionResourceModel struct {
+	Condition     types.String         `tfsdk:"condition"`
+	ExpectedValue types.String         `tfsdk:"expected_value"`
+	Identifier    types.String         `tfsdk:"identifier"`
+	PollInterval  timetypes.GoDuration `tfsdk:"poll_interval"`
+	Properties    jsontypes.Normalized `tfsdk:"properties"`
+	RoleARN       fwtypes.ARN          `tfsdk:"role_arn"`
+	Timeouts      timeouts.Value       `tfsdk:"timeouts"`
+	Triggers      fwtypes.MapOfString  `tfsdk:"triggers"`
+	TypeName      types.String         `tfsdk:"type_name"`
+	TypeVersionID types.String         `tfsdk:"type_version_id"`
+}
+
+// resourceCondition is a JMESPath expression evaluated against a resource's properties.
+type resourceCondition struct {
+	expression    string
+	expectedValue *string // If nil, the expression must evaluate to a truthy value.
+}
+
+// holds returns whether the condition holds for the specified JSON properties document.
+func (c resourceCondition) holds(properties string) (bool, error) {
+	var document any
+	if err := json.Unmarshal([]byte(properties), &document); err != nil {
+		return false, fmt.Errorf("parsing resource properties: %w", err)
+	}
+
+	result, err := jmespath.Search(c.expression, document)
+
+	if err != nil {
+		return false, fmt.Errorf("evaluating condition: %w", err)
+	}
+
+	if c.expectedValue == nil {
+		return isTruthy(result), nil
+	}
+
+	if v, ok := result.(string); ok {
+		return v == aws.ToString(c.expectedValue), nil
+	}
+
+	// Compare non-string results by their JSON representation, e.g. `3` or `true`.
+	v, err := json.Marshal(result)
+	if err != nil {
+		return false, err
+	}
+
+	return string(v) == aws.ToString(c.expectedValue), nil
+}
+
+// isTruthy returns whether a JMESPath result is truthy.
+// See https://jmespath.org/specification.html#or-expressions.
+func isTruthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+// waitResourceCondition waits for the condition to hold for the specified resource and returns the resource's properties.
+// The resource not existing is treated as the condition not (yet) holding.
+// tfresource.WaitUntil is used rather than tfresource.RetryUntilEqual as the poll interval is configurable
+// and a condition without an expected value holds for any truthy result.
+func waitResourceCondition(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string, condition resourceCondition, timeout, pollInterval time.Duration) (string, error) {
+	var properties string
+
+	err := tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findResource(ctx, conn, resourceID, typeName, typeVersionID, roleARN)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		properties = aws.ToString(output.Properties)
+
+		return condition.holds(properties)
+	}, tfresource.WaitOpts{
+		PollInterval: pollInterval,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return properties, nil
+}
+
+var _ validator.String = jmespathExpressionValidator{}
+
+// jmespathExpressionValidator validates that a string is a valid JMESPath expression.
+type jmespathExpressionValidator struct{}
+
+func (v jmespathExpressionValidator) Description(_ context.Context) string {
+	return "value must be a valid JMESPath expression"
+}
+
+func (v jmespathExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jmespathExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := jmespath.Compile(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid JMESPath expression", err.Error())
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceConditionHolds(t *testing.T) {
+	t.Parallel()
+
+	const properties = `{"Name": "test", "Status": "ACTIVE", "Count": 3, "Enabled": true, "Tags": [], "Endpoints": [{"Address": "example.com"}]}`
+
+	testCases := []struct {
+		Name          string
+		Expression    string
+		ExpectedValue *string
+		Expected      bool
+		ExpectError   bool
+	}{
+		{
+			Name:          "string equal",
+			Expression:    "Status",
+			ExpectedValue: aws.String("ACTIVE"),
+			Expected:      true,
+		},
+		{
+			Name:          "string not equal",
+			Expression:    "Status",
+			ExpectedValue: aws.String("PENDING"),
+		},
+		{
+			Name:          "number equal",
+			Expression:    "Count",
+			ExpectedValue: aws.String("3"),
+			Expected:      true,
+		},
+		{
+			Name:          "boolean equal",
+			Expression:    "Enabled",
+			ExpectedValue: aws.String("true"),
+			Expected:      true,
+		},
+		{
+			Name:          "comparison",
+			Expression:    "Count > `2`",
+			ExpectedValue: aws.String("true"),
+			Expected:      true,
+		},
+		{
+			Name:       "truthy",
+			Expression: "Endpoints[0].Address",
+			Expected:   true,
+		},
+		{
+			Name:       "empty list",
+			Expression: "Tags",
+		},
+		{
+			Name:       "missing",
+			Expression: "Missing",
+		},
+		{
+			Name:        "invalid expression",
+			Expression:  "Endpoints[",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudcontrol.NewResourceCondition(testCase.Expression, testCase.ExpectedValue).Holds(properties)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccCloudControlWaitForCondition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_wait_for_condition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaitForConditionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, names.AttrProperties, regexache.MustCompile(`^\{.*\}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlWaitForCondition_expectedValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_wait_for_condition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaitForConditionConfig_expectedValue(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expected_value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "5s"),
+				),
+			},
+			{
+				Config: testAccWaitForConditionConfig_expectedValue(rName, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expected_value", "14"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWaitForConditionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourceConfig_basic(rName), `
+resource "aws_cloudcontrolapi_wait_for_condition" "test" {
+  type_name  = aws_cloudcontrolapi_resource.test.type_name
+  identifier = aws_cloudcontrolapi_resource.test.id
+  condition  = "Arn"
+}
+`)
+}
+
+func testAccWaitForConditionConfig_expectedValue(rName string, retentionInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName    = %[1]q
+    RetentionInDays = %[2]d
+  })
+}
+
+resource "aws_cloudcontrolapi_wait_for_condition" "test" {
+  type_name      = aws_cloudcontrolapi_resource.test.type_name
+  identifier     = aws_cloudcontrolapi_resource.test.id
+  condition      = "RetentionInDays"
+  expected_value = "%[2]d"
+  poll_interval  = "5s"
+
+  triggers = {
+    retention_in_days = %[2]d
+  }
+}
+`, rName, retentionInDays)
+}
@@ -24,6 +24,7 @@ import (
 )
 
 // @FrameworkResource("aws_ebs_fast_snapshot_restore", name="EBS Fast Snapshot Restore")
+// @Region(overrideEnabled=true)
 func newEBSFastSnapshotRestoreResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &ebsFastSnapshotRestoreResource{}
 
@@ -189,6 +190,7 @@ func (r *ebsFastSnapshotRestoreResource) Delete(ctx context.Context, request res
 type ebsFastSnapshotRestoreResourceModel struct {
 	AvailabilityZone types.String   `tfsdk:"availability_zone"`
 	ID               types.String   `tfsdk:"id"`
+	Region           types.String   `tfsdk:"region"`
 	SnapshotID       types.String   `tfsdk:"snapshot_id"`
 	State            types.String   `tfsdk:"state"`
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
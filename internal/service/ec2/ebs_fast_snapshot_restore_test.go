@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -102,15 +103,62 @@ func TestAccEC2EBSFastSnapshotRestore_disappearsSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSFastSnapshotRestore_region(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_fast_snapshot_restore.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckEBSFastSnapshotRestoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSFastSnapshotRestoreConfig_region(rName, "alternate", acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSFastSnapshotRestoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSnapshotID, "aws_ebs_snapshot.alternate", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.RegionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSFastSnapshotRestoreConfig_region(rName, "test", acctest.Region()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSFastSnapshotRestoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSnapshotID, "aws_ebs_snapshot.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEBSFastSnapshotRestoreDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ebs_fast_snapshot_restore" {
 				continue
 			}
 
+			ctx := acctest.RegionContext(ctx, rs)
+			conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
 			_, err := tfec2.FindFastSnapshotRestoreByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAvailabilityZone], rs.Primary.Attributes[names.AttrSnapshotID])
 
 			if tfresource.NotFound(err) {
@@ -135,6 +183,7 @@ func testAccCheckEBSFastSnapshotRestoreExists(ctx context.Context, n string) res
 			return fmt.Errorf("Not found: %s", n)
 		}
 
+		ctx := acctest.RegionContext(ctx, rs)
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
 
 		_, err := tfec2.FindFastSnapshotRestoreByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAvailabilityZone], rs.Primary.Attributes[names.AttrSnapshotID])
@@ -172,3 +221,48 @@ resource "aws_ebs_fast_snapshot_restore" "test" {
 }
 `)
 }
+
+// testAccEBSFastSnapshotRestoreConfig_region creates volumes and snapshots in the provider's Region ("test")
+// and in the alternate Region ("alternate"), and enables fast snapshot restores for the named snapshot
+// using the resource's `region` argument rather than a provider alias.
+func testAccEBSFastSnapshotRestoreConfig_region(rName, snapshotName, region string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), testAccEBSFastSnapshotRestoreConfig_base(rName), fmt.Sprintf(`
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_ebs_volume" "alternate" {
+  provider = "awsalternate"
+
+  availability_zone = data.aws_availability_zones.alternate.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "alternate" {
+  provider = "awsalternate"
+
+  volume_id = aws_ebs_volume.alternate.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_fast_snapshot_restore" "test" {
+  region            = %[3]q
+  availability_zone = aws_ebs_volume.%[2]s.availability_zone
+  snapshot_id       = aws_ebs_snapshot.%[2]s.id
+}
+`, rName, snapshotName, region))
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_data_key, name="Data Key")
+func newEphemeralDataKey(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralDataKey{}, nil
+}
+
+const (
+	ERNameDataKey = "Data Key Ephemeral Resource"
+)
+
+type ephemeralDataKey struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralDataKey) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_kms_data_key"
+}
+
+func (e *ephemeralDataKey) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ciphertext_blob": schema.StringAttribute{
+				Computed: true,
+			},
+			"encryption_context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"key_spec": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataKeySpec](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("number_of_bytes")),
+				},
+			},
+			"number_of_bytes": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1024),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"without_plaintext": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralDataKey) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralDataKeyModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSClient(ctx)
+
+	keyID := data.KeyID.ValueString()
+
+	if data.WithoutPlaintext.ValueBool() {
+		var input kms.GenerateDataKeyWithoutPlaintextInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.GenerateDataKeyWithoutPlaintext(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.KMS, create.ErrActionCreating, ERNameDataKey, keyID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		data.CiphertextBlob = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.CiphertextBlob))
+		data.KeyARN = fwflex.StringToFrameworkARN(ctx, output.KeyId)
+		data.Plaintext = types.StringNull()
+	} else {
+		var input kms.GenerateDataKeyInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.GenerateDataKey(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.KMS, create.ErrActionCreating, ERNameDataKey, keyID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		data.CiphertextBlob = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.CiphertextBlob))
+		data.KeyARN = fwflex.StringToFrameworkARN(ctx, output.KeyId)
+		data.Plaintext = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.Plaintext))
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralDataKeyModel struct {
+	CiphertextBlob    types.String                             `tfsdk:"ciphertext_blob" autoflex:"-"`
+	EncryptionContext fwtypes.MapValueOf[types.String]         `tfsdk:"encryption_context"`
+	GrantTokens       fwtypes.ListValueOf[types.String]        `tfsdk:"grant_tokens"`
+	KeyARN            fwtypes.ARN                              `tfsdk:"key_arn" autoflex:"-"`
+	KeyID             types.String                             `tfsdk:"key_id"`
+	KeySpec           fwtypes.StringEnum[awstypes.DataKeySpec] `tfsdk:"key_spec"`
+	NumberOfBytes     types.Int64                              `tfsdk:"number_of_bytes"`
+	Plaintext         types.String                             `tfsdk:"plaintext" autoflex:"-"`
+	WithoutPlaintext  types.Bool                               `tfsdk:"without_plaintext"`
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSDataKeyEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	resourceName := "aws_kms_key.test"
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyEphemeralConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// A base64-encoded 256-bit data key.
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("plaintext"), knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z+/]{43}=$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("ciphertext_blob"), knownvalue.NotNull()),
+					statecheck.CompareValuePairs(
+						echoResourceName, dataPath.AtMapKey("key_arn"),
+						resourceName, tfjsonpath.New(names.AttrARN),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSDataKeyEphemeral_withoutPlaintext(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	resourceName := "aws_kms_key.test"
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyEphemeralConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("plaintext"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("ciphertext_blob"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccDataKeyEphemeralConfig_basic(withoutPlaintext bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  description             = "Testing the Terraform AWS KMS Data Key ephemeral resource"
+}
+
+ephemeral "aws_kms_data_key" "test" {
+  key_id            = aws_kms_key.test.arn
+  key_spec          = "AES_256"
+  without_plaintext = %[1]t
+
+  encryption_context = {
+    name = "value"
+  }
+}
+
+%[2]s
+`, withoutPlaintext, acctest.ConfigWithEchoProvider("ephemeral.aws_kms_data_key.test"))
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_rds_auth_token, name="Auth Token")
+func newEphemeralAuthToken(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthToken{}, nil
+}
+
+const (
+	ERNameAuthToken = "Auth Token Ephemeral Resource"
+)
+
+const (
+	// authTokenExpiration is the fixed validity period of an IAM database authentication token.
+	// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+	authTokenExpiration  = 15 * time.Minute
+	authTokenSigningName = "rds-db"
+	// emptyPayloadHash is the hex-encoded SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type ephemeralAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthToken) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_rds_auth_token"
+}
+
+func (e *ephemeralAuthToken) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auth_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralAuthTokenModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Region.IsNull() || data.Region.IsUnknown() {
+		data.Region = types.StringValue(e.Meta().Region)
+	}
+
+	endpoint := net.JoinHostPort(data.Endpoint.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+	signingTime := time.Now().UTC()
+	token, err := buildAuthToken(ctx, endpoint, data.Region.ValueString(), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx), signingTime)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionCreating, ERNameAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	expiration := signingTime.Add(authTokenExpiration)
+	data.AuthToken = types.StringValue(token)
+	data.Expiration = fwflex.TimeToFramework(ctx, &expiration)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralAuthTokenModel struct {
+	AuthToken  types.String      `tfsdk:"auth_token"`
+	Endpoint   types.String      `tfsdk:"endpoint"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Port       types.Int64       `tfsdk:"port"`
+	Region     types.String      `tfsdk:"region"`
+	Username   types.String      `tfsdk:"username"`
+}
+
+// buildAuthToken signs an IAM database authentication token locally, without calling any AWS API.
+// The implementation mirrors that of github.com/aws/aws-sdk-go-v2/feature/rds/auth.BuildAuthToken.
+func buildAuthToken(ctx context.Context, endpoint, region, dbUser string, credentialsProvider aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	if credentialsProvider == nil {
+		return "", errors.New("no AWS credentials configured")
+	}
+
+	request, err := http.NewRequest(http.MethodGet, "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	values := request.URL.Query()
+	values.Set("Action", "connect")
+	values.Set("DBUser", dbUser)
+	values.Set("X-Amz-Expires", strconv.FormatInt(int64(authTokenExpiration/time.Second), 10))
+	request.URL.RawQuery = values.Encode()
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	signedURL, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, authTokenSigningName, region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing request: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURL, "https://"), nil
+}
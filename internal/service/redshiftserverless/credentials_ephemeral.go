@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_redshiftserverless_credentials, name="Credentials")
+func newEphemeralCredentials(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralCredentials{}, nil
+}
+
+const (
+	ERNameCredentials = "Credentials Ephemeral Resource"
+)
+
+type ephemeralCredentials struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralCredentials) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_redshiftserverless_credentials"
+}
+
+func (e *ephemeralCredentials) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"db_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"db_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"db_user": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"workgroup_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralCredentials) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralCredentialsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().RedshiftServerlessClient(ctx)
+
+	if data.DurationSeconds.IsNull() || data.DurationSeconds.IsUnknown() {
+		data.DurationSeconds = types.Int64Value(900)
+	}
+
+	workgroupName := data.WorkgroupName.ValueString()
+	input := &redshiftserverless.GetCredentialsInput{
+		DbName:          fwflex.StringFromFramework(ctx, data.DBName),
+		DurationSeconds: fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+		WorkgroupName:   aws.String(workgroupName),
+	}
+
+	output, err := conn.GetCredentials(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RedshiftServerless, create.ErrActionReading, ERNameCredentials, workgroupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.DBPassword = fwflex.StringToFramework(ctx, output.DbPassword)
+	data.DBUser = fwflex.StringToFramework(ctx, output.DbUser)
+	data.Expiration = fwflex.TimeToFramework(ctx, output.Expiration)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralCredentialsModel struct {
+	DBName          types.String      `tfsdk:"db_name"`
+	DBPassword      types.String      `tfsdk:"db_password"`
+	DBUser          types.String      `tfsdk:"db_user"`
+	DurationSeconds types.Int64       `tfsdk:"duration_seconds"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	WorkgroupName   types.String      `tfsdk:"workgroup_name"`
+}
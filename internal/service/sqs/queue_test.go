@@ -16,6 +16,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -165,6 +166,53 @@ func TestAccSQSQueue_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_region(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+					resource.TestMatchResourceAttr(resourceName, names.AttrARN, regexache.MustCompile(fmt.Sprintf(`^arn:%s:sqs:%s:`, acctest.Partition(), acctest.AlternateRegion()))),
+					resource.TestMatchResourceAttr(resourceName, names.AttrURL, regexache.MustCompile(acctest.AlternateRegion())),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.RegionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_region(rName, acctest.Region()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.Region()),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "sqs", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_Name_generated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -855,6 +903,7 @@ func testAccCheckQueueExists(ctx context.Context, resourceName string, v *map[ty
 			return fmt.Errorf("No SQS Queue URL is set")
 		}
 
+		ctx := acctest.RegionContext(ctx, rs)
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
 
 		output, err := tfsqs.FindQueueAttributesByURL(ctx, conn, rs.Primary.ID)
@@ -871,13 +920,14 @@ func testAccCheckQueueExists(ctx context.Context, resourceName string, v *map[ty
 
 func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_sqs_queue" {
 				continue
 			}
 
+			ctx := acctest.RegionContext(ctx, rs)
+			conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
 			// SQS seems to be highly eventually consistent. Even if one connection reports that the queue is gone,
 			// another connection may still report it as present.
 			_, err := tfresource.RetryUntilNotFound(ctx, tfsqs.QueueDeletedTimeout, func() (any, error) {
@@ -915,6 +965,15 @@ resource "aws_sqs_queue" "test" {
 `, rName)
 }
 
+func testAccQueueConfig_region(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name   = %[1]q
+  region = %[2]q
+}
+`, rName, region)
+}
+
 func testAccQueueConfig_namePrefix(prefix string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
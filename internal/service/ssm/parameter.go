@@ -358,6 +358,10 @@ func findParameterByName(ctx context.Context, conn *ssm.Client, name string, wit
 		WithDecryption: aws.Bool(withDecryption),
 	}
 
+	return findParameter(ctx, conn, input)
+}
+
+func findParameter(ctx context.Context, conn *ssm.Client, input *ssm.GetParameterInput) (*awstypes.Parameter, error) {
 	output, err := conn.GetParameter(ctx, input)
 
 	if errs.IsA[*awstypes.ParameterNotFound](err) {
@@ -378,6 +382,23 @@ func findParameterByName(ctx context.Context, conn *ssm.Client, name string, wit
 	return output.Parameter, nil
 }
 
+func findParametersByPath(ctx context.Context, conn *ssm.Client, input *ssm.GetParametersByPathInput) ([]awstypes.Parameter, error) {
+	var output []awstypes.Parameter
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Parameters...)
+	}
+
+	return output, nil
+}
+
 func findParameterMetadataByName(ctx context.Context, conn *ssm.Client, name string) (*awstypes.ParameterMetadata, error) {
 	input := &ssm.DescribeParametersInput{
 		ParameterFilters: []awstypes.ParameterStringFilter{
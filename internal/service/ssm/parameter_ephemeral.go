@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_ssm_parameter, name="Parameter")
+func newEphemeralParameter(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralParameter{}, nil
+}
+
+const (
+	ERNameParameter = "Parameter Ephemeral Resource"
+)
+
+type ephemeralParameter struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralParameter) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (e *ephemeralParameter) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			"label": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("label")),
+				},
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralParameter) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralParameterModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSMClient(ctx)
+
+	// A specific version or label is selected by appending it to the parameter name.
+	name := data.Name.ValueString()
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		name = fmt.Sprintf("%s:%d", name, data.Version.ValueInt64())
+	} else if !data.Label.IsNull() && !data.Label.IsUnknown() {
+		name = fmt.Sprintf("%s:%s", name, data.Label.ValueString())
+	}
+
+	if data.WithDecryption.IsNull() || data.WithDecryption.IsUnknown() {
+		data.WithDecryption = types.BoolValue(true)
+	}
+
+	input := &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: fwflex.BoolFromFramework(ctx, data.WithDecryption),
+	}
+
+	output, err := findParameter(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSM, create.ErrActionReading, ERNameParameter, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFrameworkARN(ctx, output.ARN)
+	data.Type = fwflex.StringValueToFramework(ctx, output.Type)
+	data.Value = fwflex.StringToFramework(ctx, output.Value)
+	data.Version = types.Int64Value(output.Version)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralParameterModel struct {
+	ARN            fwtypes.ARN  `tfsdk:"arn"`
+	Label          types.String `tfsdk:"label"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+}
+
+// @EphemeralResource(aws_ssm_parameters_by_path, name="Parameters By Path")
+func newEphemeralParametersByPath(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralParametersByPath{}, nil
+}
+
+const (
+	ERNameParametersByPath = "Parameters By Path Ephemeral Resource"
+)
+
+type ephemeralParametersByPath struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralParametersByPath) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ssm_parameters_by_path"
+}
+
+func (e *ephemeralParametersByPath) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrPath: schema.StringAttribute{
+				Required: true,
+			},
+			"recursive": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrValues: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralParametersByPath) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralParametersByPathModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSMClient(ctx)
+
+	if data.Recursive.IsNull() || data.Recursive.IsUnknown() {
+		data.Recursive = types.BoolValue(false)
+	}
+	if data.WithDecryption.IsNull() || data.WithDecryption.IsUnknown() {
+		data.WithDecryption = types.BoolValue(true)
+	}
+
+	parameterPath := data.Path.ValueString()
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(parameterPath),
+		Recursive:      fwflex.BoolFromFramework(ctx, data.Recursive),
+		WithDecryption: fwflex.BoolFromFramework(ctx, data.WithDecryption),
+	}
+
+	output, err := findParametersByPath(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSM, create.ErrActionReading, ERNameParametersByPath, parameterPath, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.Parameter) string {
+		return aws.ToString(v.ARN)
+	}))
+	data.Names = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.Parameter) string {
+		return aws.ToString(v.Name)
+	}))
+	data.Types = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.Parameter) string {
+		return string(v.Type)
+	}))
+	data.Values = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.Parameter) string {
+		return aws.ToString(v.Value)
+	}))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralParametersByPathModel struct {
+	ARNs           fwtypes.ListValueOf[types.String] `tfsdk:"arns"`
+	Names          fwtypes.ListValueOf[types.String] `tfsdk:"names"`
+	Path           types.String                      `tfsdk:"path"`
+	Recursive      types.Bool                        `tfsdk:"recursive"`
+	Types          fwtypes.ListValueOf[types.String] `tfsdk:"types"`
+	Values         fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+	WithDecryption types.Bool                        `tfsdk:"with_decryption"`
+}
@@ -72,17 +72,11 @@ func dataSourceParametersReadByPath(ctx context.Context, d *schema.ResourceData,
 		Recursive:      aws.Bool(d.Get("recursive").(bool)),
 		WithDecryption: aws.Bool(d.Get("with_decryption").(bool)),
 	}
-	var output []awstypes.Parameter
 
-	pages := ssm.NewGetParametersByPathPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	output, err := findParametersByPath(ctx, conn, input)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading SSM Parameters by path (%s): %s", path, err)
-		}
-
-		output = append(output, page.Parameters...)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters by path (%s): %s", path, err)
 	}
 
 	d.SetId(path)
@@ -13,12 +13,16 @@ import (
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	sweep.AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := sweep.Context(region)
 			ctx = log.WithResourceType(ctx, name)
 
+			if err := sweep.ValidateSweepOptions(); err != nil {
+				return err
+			}
+
 			client, err := sweep.SharedRegionalSweepClient(ctx, region)
 			if err != nil {
 				return fmt.Errorf("getting client: %w", err)
@@ -30,6 +34,8 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
 					"error": err.Error(),
 				})
+				sweep.Summary().RecordSkipped(region, name, err)
+				sweep.Summary().Log(ctx, region, name)
 				return nil
 			}
 			if err != nil {
@@ -8,14 +8,32 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/resourcetype"
 )
 
+type regionContextKeyType int
+
+var regionContextKey regionContextKeyType
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	ctx = tfsdklog.RegisterStdlogSink(ctx)
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	if name := getRunningSweeper(); name != "" {
+		ctx = resourcetype.NewContext(ctx, name)
+	}
+
 	return ctx
 }
+
+// regionFromContext returns the Region being swept, or the empty string if the Context carries none.
+func regionFromContext(ctx context.Context) string {
+	region, _ := ctx.Value(regionContextKey).(string)
+
+	return region
+}
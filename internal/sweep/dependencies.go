@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// sweeperDependencies records, for each resource type, the resource types that must be swept before it.
+var sweeperDependencies = struct {
+	lock  sync.Mutex
+	store map[string][]string
+}{
+	store: make(map[string][]string),
+}
+
+// RegisterDependencies records that resources of the specified type must be deleted
+// after all resources of each of the dependency types.
+// The dependencies are used by SweepOrchestrator to order deletion of Sweepables created with WithResourceType.
+func RegisterDependencies(resourceType string, dependencies ...string) {
+	sweeperDependencies.lock.Lock()
+	defer sweeperDependencies.lock.Unlock()
+
+	for _, dependency := range dependencies {
+		if !slices.Contains(sweeperDependencies.store[resourceType], dependency) {
+			sweeperDependencies.store[resourceType] = append(sweeperDependencies.store[resourceType], dependency)
+		}
+	}
+}
+
+type typedSweepable struct {
+	Sweepable
+	resourceType string
+}
+
+// WithResourceType associates each of the specified Sweepables with a resource type.
+func WithResourceType(resourceType string, sweepables ...Sweepable) []Sweepable {
+	return tfslices.ApplyToAll(sweepables, func(s Sweepable) Sweepable {
+		return typedSweepable{
+			Sweepable:    s,
+			resourceType: resourceType,
+		}
+	})
+}
+
+// withDefaultResourceType associates each of the specified Sweepables not already associated with a resource type
+// with the specified resource type.
+func withDefaultResourceType(resourceType string, sweepables []Sweepable) []Sweepable {
+	return tfslices.ApplyToAll(sweepables, func(s Sweepable) Sweepable {
+		if resourceTypeOf(s) != "" {
+			return s
+		}
+
+		return typedSweepable{
+			Sweepable:    s,
+			resourceType: resourceType,
+		}
+	})
+}
+
+// resourceTypeOf returns the resource type associated with the specified Sweepable,
+// or the empty string if it has none.
+func resourceTypeOf(s Sweepable) string {
+	if v, ok := s.(typedSweepable); ok {
+		return v.resourceType
+	}
+
+	return ""
+}
+
+// sweepWaves partitions the specified Sweepables into waves.
+// All Sweepables in a wave can be deleted concurrently and every resource type's
+// dependencies are in earlier waves. Sweepables with no resource type are in the first wave.
+// Returns an error if a dependency cycle is detected.
+func sweepWaves(sweepables []Sweepable) ([][]Sweepable, error) {
+	sweeperDependencies.lock.Lock()
+	defer sweeperDependencies.lock.Unlock()
+
+	g := depgraph.New()
+	byResourceType := make(map[string][]Sweepable)
+
+	// Add the resource types being swept and, transitively, their dependencies so that
+	// ordering is preserved through resource types with nothing to sweep.
+	var todo []string
+	for _, s := range sweepables {
+		resourceType := resourceTypeOf(s)
+		byResourceType[resourceType] = append(byResourceType[resourceType], s)
+		todo = append(todo, resourceType)
+	}
+
+	var resourceTypes []string
+	for len(todo) > 0 {
+		resourceType := todo[0]
+		todo = todo[1:]
+
+		if g.HasNode(resourceType) {
+			continue
+		}
+
+		g.AddNode(resourceType)
+		resourceTypes = append(resourceTypes, resourceType)
+		todo = append(todo, sweeperDependencies.store[resourceType]...)
+	}
+
+	for _, resourceType := range resourceTypes {
+		for _, dependency := range sweeperDependencies.store[resourceType] {
+			if err := g.AddDependency(resourceType, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Dependencies always appear before their dependents in the overall order.
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make(map[string]int, len(order))
+	maxLevel := 0
+	for _, resourceType := range order {
+		dependencies, err := g.DirectDependenciesOf(resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		level := 0
+		for _, dependency := range dependencies {
+			level = max(level, levels[dependency]+1)
+		}
+		levels[resourceType] = level
+		maxLevel = max(maxLevel, level)
+	}
+
+	waves := make([][]Sweepable, maxLevel+1)
+	for _, resourceType := range order {
+		if v, ok := byResourceType[resourceType]; ok {
+			waves[levels[resourceType]] = append(waves[levels[resourceType]], v...)
+		}
+	}
+
+	// Drop levels containing only resource types with nothing to sweep.
+	return slices.DeleteFunc(waves, func(wave []Sweepable) bool {
+		return len(wave) == 0
+	}), nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/resourcetype"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id  string
+	err error
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	return s.err
+}
+
+func TestSweepWaves(t *testing.T) {
+	t.Parallel()
+
+	RegisterDependencies("test_waves_vpc", "test_waves_subnet", "test_waves_security_group")
+	RegisterDependencies("test_waves_security_group", "test_waves_network_interface")
+	RegisterDependencies("test_waves_subnet", "test_waves_network_interface")
+	RegisterDependencies("test_waves_network_interface", "test_waves_instance")
+
+	var sweepables []Sweepable
+	sweepables = append(sweepables, WithResourceType("test_waves_vpc", testSweepable{id: "vpc-1"})...)
+	sweepables = append(sweepables, testSweepable{id: "untyped-1"})
+	sweepables = append(sweepables, WithResourceType("test_waves_subnet", testSweepable{id: "subnet-1"}, testSweepable{id: "subnet-2"})...)
+	sweepables = append(sweepables, WithResourceType("test_waves_security_group", testSweepable{id: "sg-1"})...)
+	// No network interfaces to sweep, but the instance must still be swept before the subnets.
+	sweepables = append(sweepables, WithResourceType("test_waves_instance", testSweepable{id: "i-1"})...)
+
+	waves, err := sweepWaves(sweepables)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := make([][]string, 0, len(waves))
+	for _, wave := range waves {
+		var ids []string
+		for _, s := range wave {
+			if v, ok := s.(typedSweepable); ok {
+				s = v.Sweepable
+			}
+			ids = append(ids, s.(testSweepable).id)
+		}
+		got = append(got, ids)
+	}
+
+	want := [][]string{
+		{"i-1", "untyped-1"},
+		{"subnet-1", "subnet-2", "sg-1"},
+		{"vpc-1"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestSweepWavesCycle(t *testing.T) {
+	t.Parallel()
+
+	RegisterDependencies("test_cycle_a", "test_cycle_b")
+	RegisterDependencies("test_cycle_b", "test_cycle_a")
+
+	_, err := sweepWaves(WithResourceType("test_cycle_a", testSweepable{id: "a-1"}))
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestSweepOrchestratorReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := Context("us-west-2")
+	const resourceType = "test_report_thing"
+
+	sweepables := WithResourceType(resourceType,
+		testSweepable{id: "thing-1"},
+		testSweepable{id: "thing-2"},
+		testSweepable{id: "thing-3", err: errors.New("still in use")},
+	)
+
+	if err := SweepOrchestrator(ctx, sweepables); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	got, ok := Summary().Result("us-west-2", resourceType)
+	if !ok {
+		t.Fatalf("no result recorded for %s", resourceType)
+	}
+
+	want := ResourceTypeResult{Deleted: 2, Failed: 1}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	if _, ok := Summary().Result("us-east-1", resourceType); ok {
+		t.Errorf("result recorded for %s in another Region", resourceType)
+	}
+}
+
+func TestSweepOrchestratorDefaultResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := resourcetype.NewContext(context.Background(), "test_default_thing")
+
+	sweepables := []Sweepable{testSweepable{id: "thing-1"}}
+	sweepables = append(sweepables, WithResourceType("test_default_other_thing", testSweepable{id: "other-1"})...)
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, resourceType := range []string{"test_default_thing", "test_default_other_thing"} {
+		got, ok := Summary().Result("", resourceType)
+		if !ok {
+			t.Fatalf("no result recorded for %s", resourceType)
+		}
+
+		want := ResourceTypeResult{Deleted: 1}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("%s: unexpected diff (+want, -got): %s", resourceType, diff)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceDescription describes a resource that a Sweepable would delete.
+type ResourceDescription = filter.Resource
+
+// Describable is implemented by Sweepables that can describe the resource they delete.
+// Sweep filters can only be applied to Describable Sweepables, other Sweepables are never deleted when a filter is set.
+type Describable interface {
+	// Describe returns a description of the resource to be deleted.
+	// If refresh is true the resource is read first so that its tags and creation time are known.
+	Describe(ctx context.Context, refresh bool) (ResourceDescription, error)
+}
+
+// sweepOptions control which resources SweepOrchestrator deletes.
+type sweepOptions struct {
+	dryRun bool
+	filter filter.Filter
+}
+
+// sweepOptionsFromEnv returns the sweep options configured via environment variables.
+func sweepOptionsFromEnv() (sweepOptions, error) {
+	var opts sweepOptions
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.dryRun = dryRun
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		tags, err := filter.ParseTags(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepTags, err)
+		}
+		opts.filter.Tags = tags
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		opts.filter.MinAge = minAge
+	}
+
+	if v := os.Getenv(envvar.SweepNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepNameRegex, err)
+		}
+		opts.filter.NameRegex = re
+	}
+
+	return opts, nil
+}
+
+// ValidateSweepOptions returns an error if the sweep options configured via environment variables are invalid.
+func ValidateSweepOptions() error {
+	_, err := sweepOptionsFromEnv()
+
+	return err
+}
+
+// selected returns whether the options select the Sweepable's resource for deletion, along with the resource's description if known.
+func (o sweepOptions) selected(ctx context.Context, sweepable Sweepable) (ResourceDescription, bool, error) {
+	if !o.dryRun && o.filter.IsEmpty() {
+		return ResourceDescription{}, true, nil
+	}
+
+	if v, ok := sweepable.(typedSweepable); ok {
+		sweepable = v.Sweepable
+	}
+
+	describable, ok := sweepable.(Describable)
+	if !ok {
+		if o.filter.IsEmpty() {
+			return ResourceDescription{}, true, nil
+		}
+
+		tflog.Warn(ctx, "Excluding resource, sweep filters cannot be applied", map[string]any{
+			"sweepable": fmt.Sprintf("%T", sweepable),
+		})
+		return ResourceDescription{}, false, nil
+	}
+
+	r, err := describable.Describe(ctx, o.filter.RequiresRefresh())
+
+	if tfresource.NotFound(err) {
+		tflog.Debug(ctx, "Excluding resource, no longer exists")
+		return ResourceDescription{}, false, nil
+	}
+
+	if err != nil {
+		return ResourceDescription{}, false, fmt.Errorf("describing resource: %w", err)
+	}
+
+	if ok, reason := o.filter.Match(r, time.Now()); !ok {
+		tflog.Debug(ctx, "Excluding resource", map[string]any{
+			"id":     r.ID,
+			"reason": reason,
+		})
+		return r, false, nil
+	}
+
+	return r, true, nil
+}
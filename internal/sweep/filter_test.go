@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testDescribableSweepable struct {
+	resource ResourceDescription
+	deleted  *atomic.Int32
+}
+
+func (s testDescribableSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	s.deleted.Add(1)
+	return nil
+}
+
+func (s testDescribableSweepable) Describe(ctx context.Context, refresh bool) (ResourceDescription, error) {
+	return s.resource, nil
+}
+
+func TestSweepOptionsFromEnv(t *testing.T) {
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepTags, "Owner=team-a,Ephemeral")
+	t.Setenv(envvar.SweepMinAge, "36h")
+	t.Setenv(envvar.SweepNameRegex, "^tf-acc-test-")
+
+	opts, err := sweepOptionsFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !opts.dryRun {
+		t.Error("expected dry run")
+	}
+	if got, want := opts.filter.MinAge, 36*time.Hour; got != want {
+		t.Errorf("MinAge = %s, want %s", got, want)
+	}
+	if got, want := opts.filter.NameRegex.String(), "^tf-acc-test-"; got != want {
+		t.Errorf("NameRegex = %s, want %s", got, want)
+	}
+	if got, want := len(opts.filter.Tags), 2; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	t.Setenv(envvar.SweepMinAge, "36 hours")
+
+	if _, err := sweepOptionsFromEnv(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const resourceType = "test_dry_run_thing"
+
+	var deleted atomic.Int32
+	sweepables := WithResourceType(resourceType,
+		testDescribableSweepable{resource: ResourceDescription{ID: "thing-1"}, deleted: &deleted},
+		testDescribableSweepable{resource: ResourceDescription{ID: "thing-2"}, deleted: &deleted},
+	)
+
+	if err := orchestrate(ctx, sweepables, sweepOptions{dryRun: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("%d resources deleted in dry-run mode", got)
+	}
+
+	got, _ := Summary().Result("", resourceType)
+	want := ResourceTypeResult{WouldDelete: 2}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const resourceType = "test_filter_thing"
+
+	var deleted atomic.Int32
+	sweepables := WithResourceType(resourceType,
+		testDescribableSweepable{resource: ResourceDescription{ID: "thing-1", Name: "tf-acc-test-1", Tags: map[string]string{"Owner": "team-a"}}, deleted: &deleted},
+		testDescribableSweepable{resource: ResourceDescription{ID: "thing-2", Name: "tf-acc-test-2", Tags: map[string]string{"Owner": "team-b"}}, deleted: &deleted},
+		testDescribableSweepable{resource: ResourceDescription{ID: "thing-3", Name: "production", Tags: map[string]string{"Owner": "team-a"}}, deleted: &deleted},
+		// Filters can't be applied, so never deleted.
+		testSweepable{id: "thing-4"},
+	)
+
+	owner := "team-a"
+	opts := sweepOptions{
+		filter: filter.Filter{
+			Tags:      map[string]*string{"Owner": &owner},
+			NameRegex: regexp.MustCompile(`^tf-acc-test-`),
+		},
+	}
+
+	if err := orchestrate(ctx, sweepables, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := deleted.Load(), int32(1); got != want {
+		t.Errorf("%d resources deleted, want %d", got, want)
+	}
+
+	got, _ := Summary().Result("", resourceType)
+	want := ResourceTypeResult{Deleted: 1, Excluded: 3}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestSkipIfRestricted(t *testing.T) {
+	const sweeperName = "test_unorchestrated_thing"
+
+	var ran int
+	f := skipIfRestricted(sweeperName, func(string) error {
+		ran++
+		return nil
+	})
+
+	if err := f("us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := ran, 1; got != want {
+		t.Errorf("ran %d times, want %d", got, want)
+	}
+
+	t.Setenv(envvar.SweepDryRun, "true")
+
+	if err := f("us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := ran, 1; got != want {
+		t.Errorf("ran %d times in dry-run mode, want %d", got, want)
+	}
+
+	t.Setenv(envvar.SweepDryRun, "false")
+	t.Setenv(envvar.SweepTags, "Owner=team-a")
+
+	if err := f("us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := ran, 1; got != want {
+		t.Errorf("ran %d times with a filter, want %d", got, want)
+	}
+
+	got, _ := Summary().Result("us-west-2", sweeperName)
+	want := ResourceTypeResult{Skipped: errUnorchestrated.Error()}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
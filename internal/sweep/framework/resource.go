@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	sweeptags "github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,15 +52,17 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+// newResource returns a configured instance of the resource and its state populated from the sweep attributes.
+func (sr *sweepResource) newResource(ctx context.Context) (context.Context, fwresource.Resource, tfsdk.State, error) {
 	resource, err := sr.factory(ctx)
 
 	if err != nil {
-		return err
+		return ctx, nil, tfsdk.State{}, err
 	}
 
 	metadata := resourceMetadata(ctx, resource)
 	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+	ctx = sweeptags.NewContext(ctx, sr.meta, metadata.TypeName)
 
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
 
@@ -71,11 +77,102 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	return ctx, resource, state, nil
+}
+
+// Describe returns a description of the resource to be deleted, used to apply sweep filters.
+// If refresh is true the resource is read first, as sweepers usually only set the resource's identifying attributes.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (filter.Resource, error) {
+	ctx, resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return filter.Resource{}, err
+	}
+
+	if refresh {
+		response := fwresource.ReadResponse{State: state}
+		resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+		if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+			return filter.Resource{}, err
+		}
+
+		if response.State.Raw.IsNull() {
+			return filter.Resource{}, tfresource.NewEmptyResultError(nil)
+		}
+
+		state = response.State
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := state.Raw.As(&attributes); err != nil {
+		return filter.Resource{}, err
+	}
+
+	getString := func(k string) (string, bool) {
+		v, ok := attributes[k]
+		if !ok || !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+			return "", false
+		}
+		var s string
+		if err := v.As(&s); err != nil {
+			return "", false
+		}
+		return s, true
+	}
+	getMap := func(k string) (map[string]string, bool) {
+		v, ok := attributes[k]
+		if !ok || !v.IsKnown() || v.IsNull() {
+			return nil, false
+		}
+		var m map[string]tftypes.Value
+		if err := v.As(&m); err != nil {
+			return nil, false
+		}
+		tags := make(map[string]string, len(m))
+		for k, v := range m {
+			var s string
+			if err := v.As(&s); err != nil {
+				return nil, false
+			}
+			tags[k] = s
+		}
+		return tags, true
+	}
+
+	id, ok := getString(names.AttrID)
+	if !ok && len(sr.attributes) > 0 {
+		id = fmt.Sprint(sr.attributes[0].value)
+	}
+
+	r := filter.Describe(id, getString, getMap)
+
+	if refresh {
+		// Transparent tagging sets tags in state in the provider, not in the Read handler.
+		tags, ok, err := sweeptags.ResourceTags(ctx, sr.meta, getString)
+		if err != nil {
+			return filter.Resource{}, err
+		}
+		if ok {
+			r.Tags = tags
+		}
+	}
+
+	return r, nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	ctx, resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
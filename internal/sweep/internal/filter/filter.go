@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// creationTimeAttributes are the names of the attributes commonly used for a resource's creation timestamp.
+var creationTimeAttributes = []string{
+	names.AttrCreateTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// Resource describes a resource that a sweeper would delete.
+type Resource struct {
+	ID   string
+	Name string
+	Tags map[string]string
+	// CreatedAt is the zero time if the resource's creation time is not known.
+	CreatedAt time.Time
+}
+
+// Describe returns a description of a resource from its top-level attribute values.
+// getString returns the value of a string attribute and getMap that of a map of strings attribute;
+// both return false if the resource does not have the attribute or its value is not set.
+func Describe(id string, getString func(string) (string, bool), getMap func(string) (map[string]string, bool)) Resource {
+	r := Resource{
+		ID: id,
+	}
+
+	if v, ok := getString(names.AttrName); ok {
+		r.Name = v
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := getMap(k); ok && len(v) > 0 {
+			r.Tags = v
+			break
+		}
+	}
+
+	for _, k := range creationTimeAttributes {
+		if v, ok := getString(k); ok {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				r.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return r
+}
+
+// Filter selects the resources that sweepers delete.
+// The zero value selects all resources.
+type Filter struct {
+	// Tags are the tags a resource must have. A nil value matches any value of the tag.
+	Tags map[string]*string
+	// MinAge is the minimum time since a resource was created.
+	MinAge time.Duration
+	// NameRegex must match a resource's name, or its ID if it has no name.
+	NameRegex *regexp.Regexp
+}
+
+// IsEmpty returns whether the filter selects all resources.
+func (f Filter) IsEmpty() bool {
+	return len(f.Tags) == 0 && f.MinAge == 0 && f.NameRegex == nil
+}
+
+// RequiresRefresh returns whether the filter inspects resource state, tags and creation time,
+// that is not usually known until the resource has been read.
+func (f Filter) RequiresRefresh() bool {
+	return len(f.Tags) > 0 || f.MinAge > 0
+}
+
+// Match returns whether the filter selects the resource and, if not, why.
+// Resources whose tags or creation time are not known are never selected by a filter on those values.
+func (f Filter) Match(r Resource, now time.Time) (bool, string) {
+	if f.NameRegex != nil {
+		name := r.Name
+		if name == "" {
+			name = r.ID
+		}
+		if !f.NameRegex.MatchString(name) {
+			return false, fmt.Sprintf("name %q does not match %q", name, f.NameRegex)
+		}
+	}
+
+	for k, want := range f.Tags {
+		got, ok := r.Tags[k]
+		if !ok {
+			return false, fmt.Sprintf("tag %q not set", k)
+		}
+		if want != nil && got != *want {
+			return false, fmt.Sprintf("tag %q has value %q", k, got)
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.CreatedAt.IsZero() {
+			return false, "creation time not known"
+		}
+		if age := now.Sub(r.CreatedAt); age < f.MinAge {
+			return false, fmt.Sprintf("created %s ago", age.Truncate(time.Second))
+		}
+	}
+
+	return true, ""
+}
+
+// ParseTags parses a comma-separated list of tag filters.
+// Each element is either "key=value", matching tags with that key and value, or "key", matching tags with that key.
+func ParseTags(s string) (map[string]*string, error) {
+	tags := make(map[string]*string)
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(v, "=")
+		if key == "" {
+			return nil, fmt.Errorf("tag filter %q has no key", v)
+		}
+		if found {
+			tags[key] = &value
+		} else {
+			tags[key] = nil
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	value := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		input       string
+		expected    map[string]*string
+		expectError bool
+	}{
+		"empty": {
+			input:    "",
+			expected: map[string]*string{},
+		},
+		"key and value": {
+			input: "Owner=team-a",
+			expected: map[string]*string{
+				"Owner": value("team-a"),
+			},
+		},
+		"key only": {
+			input: "Ephemeral",
+			expected: map[string]*string{
+				"Ephemeral": nil,
+			},
+		},
+		"empty value": {
+			input: "Owner=",
+			expected: map[string]*string{
+				"Owner": value(""),
+			},
+		},
+		"multiple": {
+			input: "Owner=team-a, Ephemeral ,Env=test=1",
+			expected: map[string]*string{
+				"Owner":     value("team-a"),
+				"Ephemeral": nil,
+				"Env":       value("test=1"),
+			},
+		},
+		"no key": {
+			input:       "=team-a",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTags(testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseTags(%q) err %t, want %t", testCase.input, got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	owner := "team-a"
+	resource := Resource{
+		ID:   "vpc-12345678",
+		Name: "tf-acc-test-123",
+		Tags: map[string]string{
+			"Owner":     "team-a",
+			"Ephemeral": "",
+		},
+		CreatedAt: now.Add(-48 * time.Hour),
+	}
+
+	testCases := map[string]struct {
+		filter   Filter
+		resource Resource
+		expected bool
+	}{
+		"empty filter": {
+			resource: resource,
+			expected: true,
+		},
+		"name matches": {
+			filter:   Filter{NameRegex: regexp.MustCompile(`^tf-acc-test-`)},
+			resource: resource,
+			expected: true,
+		},
+		"name does not match": {
+			filter:   Filter{NameRegex: regexp.MustCompile(`^team-b-`)},
+			resource: resource,
+			expected: false,
+		},
+		"ID matches when no name": {
+			filter:   Filter{NameRegex: regexp.MustCompile(`^vpc-`)},
+			resource: Resource{ID: "vpc-12345678"},
+			expected: true,
+		},
+		"tag value matches": {
+			filter:   Filter{Tags: map[string]*string{"Owner": &owner}},
+			resource: resource,
+			expected: true,
+		},
+		"tag key matches": {
+			filter:   Filter{Tags: map[string]*string{"Ephemeral": nil}},
+			resource: resource,
+			expected: true,
+		},
+		"tag value does not match": {
+			filter:   Filter{Tags: map[string]*string{"Ephemeral": &owner}},
+			resource: resource,
+			expected: false,
+		},
+		"tag not set": {
+			filter:   Filter{Tags: map[string]*string{"CostCenter": nil}},
+			resource: resource,
+			expected: false,
+		},
+		"tags not known": {
+			filter:   Filter{Tags: map[string]*string{"Owner": &owner}},
+			resource: Resource{ID: "vpc-12345678"},
+			expected: false,
+		},
+		"old enough": {
+			filter:   Filter{MinAge: 24 * time.Hour},
+			resource: resource,
+			expected: true,
+		},
+		"too new": {
+			filter:   Filter{MinAge: 72 * time.Hour},
+			resource: resource,
+			expected: false,
+		},
+		"creation time not known": {
+			filter:   Filter{MinAge: 24 * time.Hour},
+			resource: Resource{ID: "vpc-12345678"},
+			expected: false,
+		},
+		"all match": {
+			filter: Filter{
+				Tags:      map[string]*string{"Owner": &owner},
+				MinAge:    24 * time.Hour,
+				NameRegex: regexp.MustCompile(`^tf-acc-test-`),
+			},
+			resource: resource,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.Match(testCase.resource, now)
+
+			if got != testCase.expected {
+				t.Errorf("Match() = %t (%s), want %t", got, reason, testCase.expected)
+			}
+			if !got && reason == "" {
+				t.Error("Match() returned no reason")
+			}
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	stringAttributes := map[string]string{
+		"name":          "tf-acc-test-123",
+		"create_time":   "not a timestamp",
+		"creation_date": "2024-06-01T12:00:00Z",
+	}
+	mapAttributes := map[string]map[string]string{
+		"tags_all": {"Owner": "team-a", "Env": "test"},
+		"tags":     {"Owner": "team-a"},
+	}
+
+	got := Describe("id-1",
+		func(k string) (string, bool) { v, ok := stringAttributes[k]; return v, ok },
+		func(k string) (map[string]string, bool) { v, ok := mapAttributes[k]; return v, ok },
+	)
+
+	want := Resource{
+		ID:        "id-1",
+		Name:      "tf-acc-test-123",
+		Tags:      map[string]string{"Owner": "team-a", "Env": "test"},
+		CreatedAt: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcetype
+
+import (
+	"context"
+)
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewContext returns a Context carrying the type of the resource being swept.
+func NewContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, contextKey, typeName)
+}
+
+// FromContext returns the type of the resource being swept, or the empty string if the Context carries none.
+func FromContext(ctx context.Context) string {
+	typeName, _ := ctx.Value(contextKey).(string)
+
+	return typeName
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// registration is a resource type's registration in a service package.
+type registration struct {
+	servicePackageName string
+	name               string
+	sdkFactory         func() *schema.Resource
+	tags               *types.ServicePackageResourceTags
+}
+
+var (
+	registrationIndexLock sync.Mutex
+	registrationIndex     func() map[string]registration
+)
+
+// findRegistration returns the registration of the specified resource type.
+func findRegistration(ctx context.Context, meta *conns.AWSClient, typeName string) (registration, bool) {
+	v, ok := registrations(ctx, meta)[typeName]
+
+	return v, ok
+}
+
+// registrations returns the registrations of all resource types in the client's service packages, keyed by resource type.
+// The index is built once as every sweeper client has the same service packages.
+func registrations(ctx context.Context, meta *conns.AWSClient) map[string]registration {
+	registrationIndexLock.Lock()
+	if registrationIndex == nil {
+		registrationIndex = sync.OnceValue(func() map[string]registration {
+			return newRegistrationIndex(ctx, meta.ServicePackages)
+		})
+	}
+	index := registrationIndex
+	registrationIndexLock.Unlock()
+
+	return index()
+}
+
+func newRegistrationIndex(ctx context.Context, servicePackages map[string]conns.ServicePackage) map[string]registration {
+	index := make(map[string]registration)
+
+	for _, sp := range servicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, r := range sp.SDKResources(ctx) {
+			index[r.TypeName] = registration{
+				servicePackageName: servicePackageName,
+				name:               r.Name,
+				sdkFactory:         r.Factory,
+				tags:               r.Tags,
+			}
+		}
+
+		for _, r := range sp.FrameworkResources(ctx) {
+			resource, err := r.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var metadata fwresource.MetadataResponse
+			resource.Metadata(ctx, fwresource.MetadataRequest{}, &metadata)
+
+			index[metadata.TypeName] = registration{
+				servicePackageName: servicePackageName,
+				name:               r.Name,
+				tags:               r.Tags,
+			}
+		}
+	}
+
+	return index
+}
+
+// NewContext returns a Context for calling the CRUD handlers of a Plugin Framework resource of the specified type
+// outside of the provider. As when called by the provider, resources that support transparent tagging set the tags
+// returned from AWS in the Context.
+func NewContext(ctx context.Context, meta *conns.AWSClient, typeName string) context.Context {
+	if r, ok := findRegistration(ctx, meta, typeName); ok {
+		ctx = conns.NewResourceContext(ctx, r.servicePackageName, typeName, r.name)
+	}
+
+	return tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
+}
+
+// NewSDKContext returns a Context for calling the CRUD handlers of the specified Plugin SDK resource outside of the provider.
+// As Plugin SDK resources don't know their own type, typeName is only used if the schema of the resource
+// registered for that type matches the resource's schema.
+func NewSDKContext(ctx context.Context, meta *conns.AWSClient, typeName string, resource *schema.Resource) context.Context {
+	if r, ok := findRegistration(ctx, meta, typeName); ok && r.sdkFactory != nil && sameAttributes(r.sdkFactory().SchemaMap(), resource.SchemaMap()) {
+		ctx = conns.NewResourceContext(ctx, r.servicePackageName, typeName, r.name)
+	}
+
+	return tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
+}
+
+func sameAttributes(x, y map[string]*schema.Schema) bool {
+	xKeys, yKeys := maps.Keys(x), maps.Keys(y)
+	slices.Sort(xKeys)
+	slices.Sort(yKeys)
+
+	return slices.Equal(xKeys, yKeys)
+}
+
+// ResourceTags returns the tags of a resource that supports transparent tagging once it has been read
+// using a Context returned by NewContext or NewSDKContext.
+// The tags are those set in Context by the resource's Read handler or, if it set none, those listed via the
+// resource's service package, with system tags and any provider ignore_tags removed, i.e. the value of tags_all.
+// getString returns the value of a resource attribute and is used to get the resource's tagging identifier.
+// Returns false if the resource doesn't support transparent tagging.
+func ResourceTags(ctx context.Context, meta *conns.AWSClient, getString func(string) (string, bool)) (map[string]string, bool, error) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil, false, nil
+	}
+
+	r, ok := findRegistration(ctx, meta, inContext.TypeName)
+	if !ok || r.tags == nil {
+		return nil, false, nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, false, nil
+	}
+
+	// If the Read handler didn't set tags, try and read them from the service API.
+	if tagsInContext.TagsOut.IsNone() {
+		identifierAttribute := r.tags.IdentifierAttribute
+		if identifierAttribute == "" {
+			return nil, false, nil
+		}
+
+		identifier, ok := getString(identifierAttribute)
+		if !ok || identifier == "" {
+			return nil, false, nil
+		}
+
+		var err error
+		sp := meta.ServicePackages[r.servicePackageName]
+		if v, ok := sp.(tftags.ServiceTagLister); ok {
+			err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
+		} else if v, ok := sp.(tftags.ResourceTypeTagLister); ok && r.tags.ResourceType != "" {
+			err = v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
+		} else {
+			return nil, false, nil
+		}
+
+		if err != nil {
+			return nil, false, fmt.Errorf("listing tags for %s (%s): %w", inContext.TypeName, identifier, err)
+		}
+	}
+
+	tags, err := tagsInContext.TagsOut.Unwrap()
+	if err != nil {
+		return nil, false, nil
+	}
+
+	return tagsInContext.Pipeline(r.servicePackageName).ResourceTagsAll(tags).Map(), true, nil
+}
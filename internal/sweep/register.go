@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// runningSweeper is the name of the sweeper currently running.
+// The acceptance test framework runs sweepers one at a time.
+var runningSweeper = struct {
+	lock sync.Mutex
+	name string
+}{}
+
+func setRunningSweeper(name string) {
+	runningSweeper.lock.Lock()
+	defer runningSweeper.lock.Unlock()
+
+	runningSweeper.name = name
+}
+
+func getRunningSweeper() string {
+	runningSweeper.lock.Lock()
+	defer runningSweeper.lock.Unlock()
+
+	return runningSweeper.name
+}
+
+// sweepFailed is whether any sweeper has failed.
+// Once a sweeper has failed resource.TestMain exits the process rather than returning,
+// so the sweep Summary is logged after that and every later sweeper.
+var sweepFailed atomic.Bool
+
+// AddTestSweepers registers the specified sweeper with the acceptance test framework.
+// Sweepers are named after the type of resource they sweep: the sweeper's Dependencies are registered with
+// RegisterDependencies and Sweepables deleted by the sweeper with SweepOrchestrator that are not associated
+// with a resource type by WithResourceType are of the sweeper's resource type.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	RegisterDependencies(name, s.Dependencies...)
+
+	if f := s.F; f != nil {
+		s.F = func(region string) error {
+			setRunningSweeper(name)
+			defer setRunningSweeper("")
+
+			err := f(region)
+
+			if err != nil {
+				sweepFailed.Store(true)
+			}
+
+			if sweepFailed.Load() {
+				LogSummary()
+			}
+
+			return err
+		}
+	}
+
+	resource.AddTestSweepers(name, s)
+}
+
+// errUnorchestrated is the reason sweepers registered with AddUnorchestratedTestSweepers are skipped.
+var errUnorchestrated = errors.New("deletes resources without SweepOrchestrator, not run in dry-run mode or with sweep filters")
+
+// AddUnorchestratedTestSweepers registers the specified sweeper with the acceptance test framework.
+// Use it rather than AddTestSweepers for sweepers that delete or modify resources other than with SweepOrchestrator.
+// Such sweepers would ignore dry-run mode and the sweep filters, so they are skipped when either is configured.
+func AddUnorchestratedTestSweepers(name string, s *resource.Sweeper) {
+	if f := s.F; f != nil {
+		s.F = skipIfRestricted(name, f)
+	}
+
+	AddTestSweepers(name, s)
+}
+
+// skipIfRestricted returns a sweeper function that calls f unless dry-run mode or any sweep filter is configured.
+func skipIfRestricted(name string, f func(string) error) func(string) error {
+	return func(region string) error {
+		opts, err := sweepOptionsFromEnv()
+		if err != nil {
+			return err
+		}
+
+		if opts.dryRun || !opts.filter.IsEmpty() {
+			ctx := Context(region)
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"sweeper": name,
+				"error":   errUnorchestrated.Error(),
+			})
+			summary.RecordSkipped(region, name, errUnorchestrated)
+			summary.Log(ctx, region, name)
+
+			return nil
+		}
+
+		return f(region)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// unknownResourceType is reported for Sweepables not created with WithResourceType.
+const unknownResourceType = "(unknown)"
+
+// ResourceTypeResult summarizes the outcome of sweeping a single resource type.
+type ResourceTypeResult struct {
+	Deleted int
+	Failed  int
+	// Excluded is the number of resources not deleted because they were not selected by the sweep filters.
+	Excluded int
+	// WouldDelete is the number of resources that would have been deleted in dry-run mode.
+	WouldDelete int
+	// Skipped is the reason sweeping the resource type was skipped, if it was.
+	Skipped string
+}
+
+// reportKey identifies the results of sweeping a resource type in a Region.
+type reportKey struct {
+	region       string
+	resourceType string
+}
+
+// Report is a per-Region, per-resource type summary of the outcome of sweeping.
+// It is safe for concurrent use.
+type Report struct {
+	lock    sync.Mutex
+	results map[reportKey]*ResourceTypeResult
+}
+
+// NewReport returns a new, empty Report.
+func NewReport() *Report {
+	return &Report{
+		results: make(map[reportKey]*ResourceTypeResult),
+	}
+}
+
+// summary is the Report of all sweeping done in this process.
+var summary = NewReport()
+
+// Summary returns the Report of all sweeping done so far.
+func Summary() *Report {
+	return summary
+}
+
+func newReportKey(region, resourceType string) reportKey {
+	if resourceType == "" {
+		resourceType = unknownResourceType
+	}
+
+	return reportKey{
+		region:       region,
+		resourceType: resourceType,
+	}
+}
+
+// LogSummary logs the Report of all sweeping done so far as a table.
+func LogSummary() {
+	log.Printf("[INFO] Sweep summary:\n%s", summary)
+}
+
+func (r *Report) result(region, resourceType string) *ResourceTypeResult {
+	key := newReportKey(region, resourceType)
+
+	v, ok := r.results[key]
+	if !ok {
+		v = &ResourceTypeResult{}
+		r.results[key] = v
+	}
+
+	return v
+}
+
+// RecordDeleted records the successful deletion of a resource of the specified type in the specified Region.
+func (r *Report) RecordDeleted(region, resourceType string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.result(region, resourceType).Deleted++
+}
+
+// RecordFailed records the failed deletion of a resource of the specified type in the specified Region.
+func (r *Report) RecordFailed(region, resourceType string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.result(region, resourceType).Failed++
+}
+
+// RecordExcluded records that a resource of the specified type in the specified Region was not selected by the sweep filters.
+func (r *Report) RecordExcluded(region, resourceType string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.result(region, resourceType).Excluded++
+}
+
+// RecordWouldDelete records that a resource of the specified type in the specified Region would have been deleted in dry-run mode.
+func (r *Report) RecordWouldDelete(region, resourceType string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.result(region, resourceType).WouldDelete++
+}
+
+// RecordSkipped records that sweeping the specified resource type in the specified Region was skipped, for example
+// because the service is not available in the Region.
+func (r *Report) RecordSkipped(region, resourceType string, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.result(region, resourceType).Skipped = err.Error()
+}
+
+// Result returns the result for the specified resource type in the specified Region and whether any outcome has been recorded for it.
+func (r *Report) Result(region, resourceType string) (ResourceTypeResult, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v, ok := r.results[newReportKey(region, resourceType)]
+	if !ok {
+		return ResourceTypeResult{}, false
+	}
+
+	return *v, true
+}
+
+// Log logs the results in the specified Region for the specified resource types,
+// or all resource types swept in the Region if none are specified.
+func (r *Report) Log(ctx context.Context, region string, resourceTypes ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(resourceTypes) == 0 {
+		for key := range r.results {
+			if key.region == region {
+				resourceTypes = append(resourceTypes, key.resourceType)
+			}
+		}
+	}
+	slices.Sort(resourceTypes)
+	resourceTypes = slices.Compact(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		v := r.result(region, resourceType)
+		fields := map[string]any{
+			"region":        region,
+			"resource_type": resourceType,
+			"deleted":       v.Deleted,
+			"failed":        v.Failed,
+			"excluded":      v.Excluded,
+		}
+		if v.WouldDelete > 0 {
+			fields["would_delete"] = v.WouldDelete
+		}
+		if v.Skipped != "" {
+			fields["skipped"] = v.Skipped
+		}
+		tflog.Info(ctx, "Sweep summary", fields)
+	}
+}
+
+// String returns the Report as a table with one row per Region and resource type.
+func (r *Report) String() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	keys := maps.Keys(r.results)
+	slices.SortFunc(keys, func(a, b reportKey) int {
+		return cmp.Or(strings.Compare(a.region, b.region), strings.Compare(a.resourceType, b.resourceType))
+	})
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REGION\tRESOURCE TYPE\tDELETED\tWOULD DELETE\tEXCLUDED\tFAILED\tSKIPPED")
+	for _, key := range keys {
+		v := r.results[key]
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%s\n", key.region, key.resourceType, v.Deleted, v.WouldDelete, v.Excluded, v.Failed, v.Skipped)
+	}
+	w.Flush()
+
+	return sb.String()
+}
@@ -17,7 +17,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/resourcetype"
+	sweeptags "github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +93,73 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns a description of the resource to be deleted, used to apply sweep filters.
+// If refresh is true the resource is read first, as sweepers usually only set the resource's ID.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (filter.Resource, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if refresh {
+		ctx = sweeptags.NewSDKContext(ctx, sr.meta, resourcetype.FromContext(ctx), sr.resource)
+
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			return filter.Resource{}, err
+		}
+
+		if sr.d.Id() == "" {
+			return filter.Resource{}, tfresource.NewEmptyResultError(nil)
+		}
+	}
+
+	schemaMap := sr.resource.SchemaMap()
+	getString := func(k string) (string, bool) {
+		if k == names.AttrID {
+			return sr.d.Id(), true
+		}
+		if _, ok := schemaMap[k]; !ok {
+			return "", false
+		}
+		v, ok := sr.d.GetOk(k)
+		if !ok {
+			return "", false
+		}
+		s, ok := v.(string)
+		return s, ok
+	}
+	getMap := func(k string) (map[string]string, bool) {
+		if _, ok := schemaMap[k]; !ok {
+			return nil, false
+		}
+		v, ok := sr.d.GetOk(k)
+		if !ok {
+			return nil, false
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		tags := make(map[string]string, len(m))
+		for k, v := range m {
+			tags[k], _ = v.(string)
+		}
+		return tags, true
+	}
+
+	r := filter.Describe(sr.d.Id(), getString, getMap)
+
+	if refresh {
+		// Transparent tagging sets tags in state in the provider, not in the Read handler.
+		tags, ok, err := sweeptags.ResourceTags(ctx, sr.meta, getString)
+		if err != nil {
+			return filter.Resource{}, err
+		}
+		if ok {
+			r.Tags = tags
+		}
+	}
+
+	return r, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
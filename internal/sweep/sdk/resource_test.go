@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/resourcetype"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testServicePackage struct {
+	tags map[string]string
+}
+
+func (sp testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (sp testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (sp testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (sp testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  testResourceTransparentTags,
+			TypeName: "aws_sweeptest_transparent_tags",
+			Name:     "Transparent Tags",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+	}
+}
+
+func (sp testServicePackage) ServicePackageName() string {
+	return "sweeptest"
+}
+
+func (sp testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, sp.tags))
+	}
+
+	return nil
+}
+
+func testResourceTransparentTags() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.Set(names.AttrName, "tf-acc-test-"+d.Id())
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTagsAll: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func TestSweepResourceDescribe_transparentTags(t *testing.T) {
+	t.Parallel()
+
+	sp := testServicePackage{
+		tags: map[string]string{
+			"Owner":          "team-a",
+			"aws:cloudtrail": "system",
+		},
+	}
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			sp.ServicePackageName(): sp,
+		},
+	}
+
+	testCases := map[string]struct {
+		resourceType string
+		expectedTags map[string]string
+	}{
+		"transparently tagged": {
+			resourceType: "aws_sweeptest_transparent_tags",
+			expectedTags: map[string]string{
+				"Owner": "team-a",
+			},
+		},
+		"unknown resource type": {
+			resourceType: "aws_sweeptest_unknown",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := resourcetype.NewContext(context.Background(), testCase.resourceType)
+			r := testResourceTransparentTags()
+			d := r.Data(nil)
+			d.SetId("thing-1")
+
+			got, err := NewSweepResource(r, d, meta).Describe(ctx, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := got.Name, "tf-acc-test-thing-1"; got != want {
+				t.Errorf("Name = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(got.Tags, testCase.expectedTags); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/resourcetype"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -26,6 +28,8 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+const defaultSweepConcurrency = 10
+
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
@@ -106,20 +110,103 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified Sweepables.
+// Sweepables are deleted in waves so that resources of a type are only deleted once all resources
+// of the types it depends on, as registered with RegisterDependencies, have been deleted.
+// Within a wave at most TF_AWS_SWEEP_CONCURRENCY Sweepables are deleted concurrently.
+// Sweepables not associated with a resource type are of the type of the running sweeper, if any.
+// Only resources selected by the sweep filters configured via environment variables are deleted and,
+// in dry-run mode, the resources that would be deleted are logged instead.
+// The outcome of each deletion is recorded in the sweep Summary.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	opts, err := sweepOptionsFromEnv()
+	if err != nil {
+		return err
+	}
+
+	return orchestrate(ctx, sweepables, opts, optFns...)
+}
+
+func orchestrate(ctx context.Context, sweepables []Sweepable, opts sweepOptions, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
+		return nil
+	}
+
+	if resourceType := resourcetype.FromContext(ctx); resourceType != "" {
+		sweepables = withDefaultResourceType(resourceType, sweepables)
 	}
 
-	var g multierror.Group
+	waves, err := sweepWaves(sweepables)
+	if err != nil {
+		return fmt.Errorf("ordering resources to sweep: %w", err)
+	}
 
-	for _, sweepable := range sweepables {
-		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+	region := regionFromContext(ctx)
+	concurrency := sweepConcurrency()
+	var errs *multierror.Error
+
+	for i, wave := range waves {
+		tflog.Debug(ctx, "Sweeping resources", map[string]any{
+			"wave":  i + 1,
+			"waves": len(waves),
+			"count": len(wave),
 		})
+
+		var g multierror.Group
+		semaphore := make(chan struct{}, concurrency)
+
+		for _, sweepable := range wave {
+			g.Go(func() error {
+				semaphore <- struct{}{}
+				defer func() { <-semaphore }()
+
+				resourceType := resourceTypeOf(sweepable)
+				ctx := resourcetype.NewContext(ctx, resourceType)
+				r, ok, err := opts.selected(ctx, sweepable)
+				if err != nil {
+					summary.RecordFailed(region, resourceType)
+					return err
+				}
+				if !ok {
+					summary.RecordExcluded(region, resourceType)
+					return nil
+				}
+				if opts.dryRun {
+					tflog.Info(ctx, "Would sweep resource", map[string]any{
+						"id":   r.ID,
+						"name": r.Name,
+					})
+					summary.RecordWouldDelete(region, resourceType)
+					return nil
+				}
+
+				if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+					summary.RecordFailed(region, resourceType)
+					return err
+				}
+				summary.RecordDeleted(region, resourceType)
+
+				return nil
+			})
+		}
+
+		// Continue with later waves on error, sweeping is best effort.
+		errs = multierror.Append(errs, g.Wait())
+	}
+
+	summary.Log(ctx, region, tfslices.ApplyToAll(sweepables, resourceTypeOf)...)
+
+	return errs.ErrorOrNil()
+}
+
+// sweepConcurrency returns the maximum number of Sweepables to delete concurrently.
+func sweepConcurrency() int {
+	if v, err := strconv.Atoi(os.Getenv(envvar.SweepConcurrency)); err == nil && v > 0 {
+		return v
 	}
 
-	return g.Wait().ErrorOrNil()
+	return defaultSweepConcurrency
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -18,5 +18,9 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// Only returns if sweepers ran successfully.
+	// Otherwise the summary is logged after the failed sweeper.
 	resource.TestMain(m)
+
+	sweep.LogSummary()
 }
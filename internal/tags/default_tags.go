@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"path"
+	"slices"
+)
+
+// DefaultTagsMatcher matches resources by Terraform resource type name, e.g. "aws_autoscaling_group",
+// or by service package name, e.g. "iam".
+// Resource type names may contain shell file name patterns, e.g. "aws_iam_*".
+type DefaultTagsMatcher struct {
+	ResourceTypes []string
+	Services      []string
+}
+
+// IsEmpty returns whether the matcher matches no resources.
+func (m DefaultTagsMatcher) IsEmpty() bool {
+	return len(m.ResourceTypes) == 0 && len(m.Services) == 0
+}
+
+// Match returns whether the matcher matches the specified resource.
+func (m DefaultTagsMatcher) Match(servicePackageName, typeName string) bool {
+	if servicePackageName != "" && slices.Contains(m.Services, servicePackageName) {
+		return true
+	}
+
+	if typeName != "" {
+		for _, pattern := range m.ResourceTypes {
+			if ok, _ := path.Match(pattern, typeName); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ConditionalDefaultTags contains tags to default across only the matching resources.
+type ConditionalDefaultTags struct {
+	Tags KeyValueTags
+	// Include matches the resources to which the tags apply. If empty, the tags apply to all resources.
+	Include DefaultTagsMatcher
+	// Exclude matches the resources to which the tags do not apply, even if matched by Include.
+	Exclude DefaultTagsMatcher
+}
+
+// Match returns whether the tags apply to the specified resource.
+func (c ConditionalDefaultTags) Match(servicePackageName, typeName string) bool {
+	if c.Exclude.Match(servicePackageName, typeName) {
+		return false
+	}
+
+	return c.Include.IsEmpty() || c.Include.Match(servicePackageName, typeName)
+}
+
+// ForResource returns the default tags configuration for the specified resource:
+// Tags merged with the tags of each matching Conditional, in order, or nil if the resource is excluded.
+// The returned configuration has no conditional tags, so its Tags can be used directly, e.g. by MergeTags.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if len(dc.Conditional) == 0 && dc.Exclude.IsEmpty() {
+		return dc
+	}
+
+	if dc.Exclude.Match(servicePackageName, typeName) {
+		return nil
+	}
+
+	tags := dc.Tags
+	for _, v := range dc.Conditional {
+		if v.Match(servicePackageName, typeName) {
+			tags = tags.Merge(v.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
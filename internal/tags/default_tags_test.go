@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		Conditional: []ConditionalDefaultTags{
+			{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "1234",
+				}),
+				Exclude: DefaultTagsMatcher{
+					ResourceTypes: []string{"aws_autoscaling_group"},
+					Services:      []string{"iam"},
+				},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+					"Owner":  "storage",
+				}),
+				Include: DefaultTagsMatcher{
+					ResourceTypes: []string{"aws_db_*"},
+					Services:      []string{"s3"},
+				},
+			},
+		},
+		Exclude: DefaultTagsMatcher{
+			ResourceTypes: []string{"aws_ssm_parameter"},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "all conditional tags excluded",
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:               "excluded resource type",
+			servicePackageName: "autoscaling",
+			typeName:           "aws_autoscaling_group",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:               "not included",
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:               "included by resource type pattern",
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Owner":      "storage",
+			},
+		},
+		{
+			name:               "included by service",
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Owner":      "storage",
+			},
+		},
+		{
+			name:               "opted out",
+			servicePackageName: "ssm",
+			typeName:           "aws_ssm_parameter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got.Tags.Map())
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatal("expected default tags, got nil")
+			}
+
+			if len(got.Conditional) != 0 || !got.Exclude.IsEmpty() {
+				t.Error("expected resolved default tags configuration")
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
+
+func TestDefaultConfigForResourceUnconditional(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var nilConfig *DefaultConfig
+	if got := nilConfig.ForResource("ec2", "aws_vpc"); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+	}
+	if got := defaultConfig.ForResource("ec2", "aws_vpc"); got != defaultConfig {
+		t.Errorf("expected unconditional configuration to be returned unchanged, got %v", got)
+	}
+}
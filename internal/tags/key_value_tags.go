@@ -52,6 +52,10 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Conditional contains tags to default across only the matching resources.
+	Conditional []ConditionalDefaultTags
+	// Exclude matches the resources to which no default tags apply.
+	Exclude DefaultTagsMatcher
 }
 
 // IgnoreConfig contains various options for removing resource tags.
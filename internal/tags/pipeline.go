@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Pipeline applies the provider's default_tags and ignore_tags configuration, and removes system tags,
+// when computing the tags of a service package's resources and data sources.
+// It is shared by the Plugin SDK v2 and Plugin Framework transparent tagging interceptors and
+// by the plan modifiers that compute tags_all so that all handle tags identically.
+type Pipeline struct {
+	DefaultConfig      *DefaultConfig
+	IgnoreConfig       *IgnoreConfig
+	ServicePackageName string
+}
+
+// NewPipeline returns a tag pipeline for the specified service package.
+func NewPipeline(servicePackageName string, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) Pipeline {
+	return Pipeline{
+		DefaultConfig:      defaultConfig,
+		IgnoreConfig:       ignoreConfig,
+		ServicePackageName: servicePackageName,
+	}
+}
+
+// Pipeline returns a tag pipeline for the specified service package using the tagging information in Context.
+func (v *InContext) Pipeline(servicePackageName string) Pipeline {
+	return NewPipeline(servicePackageName, v.DefaultConfig, v.IgnoreConfig)
+}
+
+// ResourceTagsIn returns the tags to apply to a resource in AWS: the resource's configured tags
+// merged with any provider default_tags, with system tags removed.
+func (p Pipeline) ResourceTagsIn(tags KeyValueTags) KeyValueTags {
+	return p.DefaultConfig.MergeTags(tags).IgnoreSystem(p.ServicePackageName)
+}
+
+// ResourceTagsAll returns the value of a resource's tags_all from the tags applied to, or returned from, AWS:
+// the tags with system tags and any provider ignore_tags removed.
+func (p Pipeline) ResourceTagsAll(tags KeyValueTags) KeyValueTags {
+	return tags.IgnoreSystem(p.ServicePackageName).IgnoreConfig(p.IgnoreConfig)
+}
+
+// PlannedTagsAll returns the planned value of a resource's tags_all from its configured tags.
+// It is the value that ResourceTagsAll returns once ResourceTagsIn have been applied.
+func (p Pipeline) PlannedTagsAll(tags KeyValueTags) KeyValueTags {
+	return p.ResourceTagsAll(p.ResourceTagsIn(tags))
+}
+
+// DataSourceTags returns the value of a data source's tags from the tags returned from AWS:
+// the tags with system tags and any provider ignore_tags removed.
+// Provider default_tags do not apply to data sources.
+func (p Pipeline) DataSourceTags(tags KeyValueTags) KeyValueTags {
+	return tags.IgnoreSystem(p.ServicePackageName).IgnoreConfig(p.IgnoreConfig)
+}
+
+// ResourceTags returns the value of a resource's tags from the tags returned from AWS by a Plugin SDK v2 resource's Read.
+// Tags that are also configured as provider default_tags are only included if they are in the resource's configuration.
+func (p Pipeline) ResourceTags(ctx context.Context, tags KeyValueTags, d schemaResourceData, tagsAttr string) KeyValueTags {
+	return p.ResourceTagsAll(tags).ResolveDuplicates(ctx, p.DefaultConfig, p.IgnoreConfig, d, tagsAttr, nil)
+}
+
+// ResourceTagsFramework returns the value of a resource's tags from the tags returned from AWS by a Plugin Framework resource's Read.
+// Tags that are also configured as provider default_tags are only included if they are in the resource's state.
+func (p Pipeline) ResourceTagsFramework(ctx context.Context, tags KeyValueTags, response *resource.ReadResponse, diags *fwdiag.Diagnostics) KeyValueTags {
+	return p.ResourceTagsAll(tags).ResolveDuplicatesFramework(ctx, p.DefaultConfig, p.IgnoreConfig, response, diags)
+}
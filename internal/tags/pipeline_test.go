@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Environment": "test",
+			"Owner":       "platform",
+		}),
+	}
+	ignoreConfig := &IgnoreConfig{
+		Keys:        New(ctx, []string{"LastScanned"}),
+		KeyPrefixes: New(ctx, []string{"kubernetes.io/"}),
+	}
+	configTags := New(ctx, map[string]string{
+		"Name":       "example",
+		"Owner":      "team-a",
+		"aws:system": "value",
+	})
+	apiTags := New(ctx, map[string]string{
+		"Environment":                     "test",
+		"LastScanned":                     "2024-06-01",
+		"Name":                            "example",
+		"Owner":                           "team-a",
+		"aws:cloudformation:stack-name":   "stack",
+		"elasticbeanstalk:environment-id": "e-123",
+		"kubernetes.io/cluster/example":   "owned",
+	})
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		f                  func(Pipeline) KeyValueTags
+		want               map[string]string
+	}{
+		{
+			name: "ResourceTagsIn",
+			f:    func(p Pipeline) KeyValueTags { return p.ResourceTagsIn(configTags) },
+			want: map[string]string{
+				"Environment": "test",
+				"Name":        "example",
+				"Owner":       "team-a",
+			},
+		},
+		{
+			name: "ResourceTagsAll",
+			f:    func(p Pipeline) KeyValueTags { return p.ResourceTagsAll(apiTags) },
+			want: map[string]string{
+				"Environment":                     "test",
+				"Name":                            "example",
+				"Owner":                           "team-a",
+				"elasticbeanstalk:environment-id": "e-123",
+			},
+		},
+		{
+			name:               "ResourceTagsAll Elastic Beanstalk",
+			servicePackageName: names.ElasticBeanstalk,
+			f:                  func(p Pipeline) KeyValueTags { return p.ResourceTagsAll(apiTags) },
+			want: map[string]string{
+				"Environment": "test",
+				"Owner":       "team-a",
+			},
+		},
+		{
+			name: "PlannedTagsAll",
+			f:    func(p Pipeline) KeyValueTags { return p.PlannedTagsAll(configTags) },
+			want: map[string]string{
+				"Environment": "test",
+				"Name":        "example",
+				"Owner":       "team-a",
+			},
+		},
+		{
+			name: "DataSourceTags",
+			f:    func(p Pipeline) KeyValueTags { return p.DataSourceTags(apiTags) },
+			want: map[string]string{
+				"Environment":                     "test",
+				"Name":                            "example",
+				"Owner":                           "team-a",
+				"elasticbeanstalk:environment-id": "e-123",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.f(NewPipeline(testCase.servicePackageName, defaultConfig, ignoreConfig))
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestPipelineNoConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{
+		"Name":       "example",
+		"aws:system": "value",
+	})
+	want := map[string]string{
+		"Name": "example",
+	}
+
+	p := NewPipeline("", nil, nil)
+
+	testKeyValueTagsVerifyMap(t, p.ResourceTagsIn(tags).Map(), want)
+	testKeyValueTagsVerifyMap(t, p.ResourceTagsAll(tags).Map(), want)
+	testKeyValueTagsVerifyMap(t, p.PlannedTagsAll(tags).Map(), want)
+	testKeyValueTagsVerifyMap(t, p.DataSourceTags(tags).Map(), want)
+}
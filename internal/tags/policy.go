@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicyMode is how violations of a tag policy are reported.
+type PolicyMode string
+
+const (
+	// PolicyModeError fails the plan of a resource whose tags violate the policy.
+	PolicyModeError PolicyMode = "error"
+	// PolicyModeWarn only warns about a resource whose tags violate the policy.
+	PolicyModeWarn PolicyMode = "warn"
+)
+
+// PolicyModes returns the valid tag policy modes.
+func PolicyModes() []string {
+	return []string{
+		string(PolicyModeError),
+		string(PolicyModeWarn),
+	}
+}
+
+// PolicyKeyCase is the case that all tag keys must have.
+type PolicyKeyCase string
+
+const (
+	PolicyKeyCaseLower PolicyKeyCase = "lower"
+	PolicyKeyCaseUpper PolicyKeyCase = "upper"
+)
+
+// PolicyKeyCases returns the valid tag policy key cases.
+func PolicyKeyCases() []string {
+	return []string{
+		string(PolicyKeyCaseLower),
+		string(PolicyKeyCaseUpper),
+	}
+}
+
+// PolicyRule is a tag policy rule for a single tag key.
+// A tag whose key matches Key ignoring case must be capitalized exactly as Key.
+type PolicyRule struct {
+	Key      string
+	Required bool
+	// AllowedValues are the tag's allowed values. A value ending in "*" allows any value with that prefix.
+	AllowedValues []string
+	ValueRegex    *regexp.Regexp
+}
+
+// PolicyConfig contains the provider tag policy, used to validate resource tags at plan time.
+type PolicyConfig struct {
+	Mode    PolicyMode
+	KeyCase PolicyKeyCase
+	Rules   []PolicyRule
+	// UseOrganizationsPolicy is whether the rules of the account's AWS Organizations effective tag policy are also enforced.
+	UseOrganizationsPolicy bool
+}
+
+// IsWarn returns whether violations of the policy are only warnings.
+func (pc *PolicyConfig) IsWarn() bool {
+	return pc != nil && pc.Mode == PolicyModeWarn
+}
+
+// Validate returns an error describing each way in which tags violate the policy, or nil if they comply.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+	m := tags.Map()
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		switch pc.KeyCase {
+		case PolicyKeyCaseLower:
+			if k != strings.ToLower(k) {
+				errs = append(errs, fmt.Errorf("tag key %q must be lower case", k))
+			}
+		case PolicyKeyCaseUpper:
+			if k != strings.ToUpper(k) {
+				errs = append(errs, fmt.Errorf("tag key %q must be upper case", k))
+			}
+		}
+	}
+
+	for _, rule := range pc.Rules {
+		idx := slices.IndexFunc(keys, func(k string) bool {
+			return strings.EqualFold(k, rule.Key)
+		})
+
+		if idx == -1 {
+			if rule.Required {
+				errs = append(errs, fmt.Errorf("required tag %q is missing", rule.Key))
+			}
+			continue
+		}
+
+		k := keys[idx]
+		if k != rule.Key {
+			errs = append(errs, fmt.Errorf("tag key %q must be capitalized as %q", k, rule.Key))
+		}
+
+		if err := rule.validateValue(k, m[k]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r PolicyRule) validateValue(key, value string) error {
+	if len(r.AllowedValues) > 0 && !slices.ContainsFunc(r.AllowedValues, func(v string) bool {
+		if prefix, ok := strings.CutSuffix(v, "*"); ok {
+			return strings.HasPrefix(value, prefix)
+		}
+		return v == value
+	}) {
+		return fmt.Errorf("tag %q value %q is not one of the allowed values: %s", key, value, strings.Join(r.AllowedValues, ", "))
+	}
+
+	if r.ValueRegex != nil && !r.ValueRegex.MatchString(value) {
+		return fmt.Errorf("tag %q value %q does not match %q", key, value, r.ValueRegex)
+	}
+
+	return nil
+}
+
+// ParseOrganizationsPolicy returns the tag policy rules in an AWS Organizations tag policy document.
+// Both effective policies and policies using inheritance operators, e.g. "@@assign", are supported.
+// Organizations tag policies only standardize tags, so none of the rules require a tag.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+func ParseOrganizationsPolicy(document string) ([]PolicyRule, error) {
+	var doc struct {
+		Tags map[string]struct {
+			TagKey   json.RawMessage `json:"tag_key"`
+			TagValue json.RawMessage `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	policyKeys := make([]string, 0, len(doc.Tags))
+	for k := range doc.Tags {
+		policyKeys = append(policyKeys, k)
+	}
+	slices.Sort(policyKeys)
+
+	rules := make([]PolicyRule, 0, len(policyKeys))
+	for _, policyKey := range policyKeys {
+		v := doc.Tags[policyKey]
+		rule := PolicyRule{
+			Key: policyKey,
+		}
+
+		if err := unmarshalOrganizationsPolicyValue(v.TagKey, &rule.Key); err != nil {
+			return nil, fmt.Errorf("parsing AWS Organizations tag policy (%s) tag_key: %w", policyKey, err)
+		}
+
+		if err := unmarshalOrganizationsPolicyValue(v.TagValue, &rule.AllowedValues); err != nil {
+			return nil, fmt.Errorf("parsing AWS Organizations tag policy (%s) tag_value: %w", policyKey, err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// unmarshalOrganizationsPolicyValue unmarshals a tag policy value that is either a plain value or an object
+// containing the value as an "@@assign" inheritance operator.
+func unmarshalOrganizationsPolicyValue(raw json.RawMessage, v any) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &operators); err == nil {
+		raw = operators["@@assign"]
+		if len(raw) == 0 {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		Mode: PolicyModeError,
+		Rules: []PolicyRule{
+			{
+				Key:      "Owner",
+				Required: true,
+			},
+			{
+				Key:           "Environment",
+				AllowedValues: []string{"dev", "prod", "sandbox-*"},
+			},
+			{
+				Key:        "CostCenter",
+				ValueRegex: regexache.MustCompile(`^\d{4}$`),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		keyCase    PolicyKeyCase
+		tags       map[string]string
+		violations []string
+	}{
+		{
+			name: "compliant",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "dev",
+				"Name":        "Example",
+				"Owner":       "platform",
+			},
+		},
+		{
+			name: "allowed value prefix",
+			tags: map[string]string{
+				"Environment": "sandbox-team-a",
+				"Owner":       "platform",
+			},
+		},
+		{
+			name: "missing required tag",
+			tags: map[string]string{
+				"Environment": "dev",
+			},
+			violations: []string{
+				`required tag "Owner" is missing`,
+			},
+		},
+		{
+			name: "key capitalization",
+			tags: map[string]string{
+				"environment": "dev",
+				"owner":       "platform",
+			},
+			violations: []string{
+				`tag key "owner" must be capitalized as "Owner"`,
+				`tag key "environment" must be capitalized as "Environment"`,
+			},
+		},
+		{
+			name: "disallowed value",
+			tags: map[string]string{
+				"Environment": "test",
+				"Owner":       "platform",
+			},
+			violations: []string{
+				`tag "Environment" value "test" is not one of the allowed values: dev, prod, sandbox-*`,
+			},
+		},
+		{
+			name: "value does not match",
+			tags: map[string]string{
+				"CostCenter": "cc-1234",
+				"Owner":      "platform",
+			},
+			violations: []string{
+				`tag "CostCenter" value "cc-1234" does not match "^\\d{4}$"`,
+			},
+		},
+		{
+			name:    "key case",
+			keyCase: PolicyKeyCaseLower,
+			tags: map[string]string{
+				"Name":  "Example",
+				"Owner": "platform",
+				"team":  "a",
+			},
+			violations: []string{
+				`tag key "Name" must be lower case`,
+				`tag key "Owner" must be lower case`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			policyConfig := *policyConfig
+			policyConfig.KeyCase = testCase.keyCase
+
+			err := policyConfig.Validate(New(ctx, testCase.tags))
+
+			var got []string
+			if err != nil {
+				got = strings.Split(err.Error(), "\n")
+			}
+
+			if diff := cmp.Diff(got, testCase.violations); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigValidateNil(t *testing.T) {
+	t.Parallel()
+
+	var policyConfig *PolicyConfig
+
+	if err := policyConfig.Validate(New(context.Background(), map[string]string{"Name": "Example"})); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if policyConfig.IsWarn() {
+		t.Error("expected nil policy not to warn")
+	}
+}
+
+func TestParseOrganizationsPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		document string
+		want     []PolicyRule
+		wantErr  bool
+	}{
+		{
+			name: "effective policy",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200*"],
+      "enforced_for": ["ec2:instance"]
+    },
+    "project": {
+      "tag_key": "Project"
+    }
+  }
+}`,
+			want: []PolicyRule{
+				{
+					Key:           "CostCenter",
+					AllowedValues: []string{"100", "200*"},
+				},
+				{
+					Key: "Project",
+				},
+			},
+		},
+		{
+			name: "inheritance operators",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      },
+      "tag_value": {
+        "@@assign": ["100"]
+      }
+    },
+    "owner": {}
+  }
+}`,
+			want: []PolicyRule{
+				{
+					Key:           "CostCenter",
+					AllowedValues: []string{"100"},
+				},
+				{
+					Key: "owner",
+				},
+			},
+		},
+		{
+			name:     "no tags",
+			document: `{}`,
+			want:     []PolicyRule{},
+		},
+		{
+			name:     "invalid JSON",
+			document: `{"tags":`,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseOrganizationsPolicy(testCase.document)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, wantErr %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
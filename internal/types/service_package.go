@@ -18,6 +18,11 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled bool // Can the resource's Region be overridden by a top-level `region` argument?
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
@@ -38,6 +43,7 @@ type ServicePackageFrameworkDataSource struct {
 type ServicePackageFrameworkResource struct {
 	Factory func(context.Context) (resource.ResourceWithConfigure, error)
 	Name    string
+	Region  *ServicePackageResourceRegion
 	Tags    *ServicePackageResourceTags
 }
 
@@ -56,5 +62,6 @@ type ServicePackageSDKResource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -21,13 +22,17 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// The tags are also validated against any provider-level tag policy.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
+	var servicePackageName string
+	if inContext, ok := conns.FromContext(ctx); ok {
+		servicePackageName = inContext.ServicePackageName
+	}
+	pipeline := tftags.NewPipeline(servicePackageName, meta.(*conns.AWSClient).DefaultTagsConfig(ctx), meta.(*conns.AWSClient).IgnoreTagsConfig(ctx))
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	allTags := pipeline.PlannedTagsAll(resourceTags)
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when there is a known diff (excluding an empty map)
@@ -42,6 +47,19 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if policy := meta.(*conns.AWSClient).TagPolicyConfig(ctx); policy != nil {
+		if err := policy.Validate(pipeline.ResourceTagsIn(resourceTags)); err != nil {
+			if !policy.IsWarn() {
+				return fmt.Errorf("tags do not comply with provider tag_policy: %w", err)
+			}
+			// Plugin SDK v2 CustomizeDiff functions can't return warning diagnostics.
+			// The tags resource interceptor reports the violations as a warning on Create and Update.
+			tflog.Warn(ctx, "tags do not comply with provider tag_policy", map[string]any{
+				"tag_policy_violations": err.Error(),
+			})
+		}
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
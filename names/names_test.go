@@ -59,6 +59,52 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServicePrincipalNameForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		service  string
+		region   string
+		expected string
+	}{
+		{
+			name:     "standard",
+			service:  "logs",
+			region:   endpoints.UsWest2RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "China logs",
+			service:  "logs",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com.cn",
+		},
+		{
+			name:     "China s3",
+			service:  "s3",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "ISO config",
+			service:  "config",
+			region:   endpoints.UsIsoEast1RegionID,
+			expected: "c2s.ic.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalNameForPartition(testCase.service, PartitionForRegion(testCase.region)), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 
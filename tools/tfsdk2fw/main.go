@@ -12,22 +12,25 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
 
 var (
-	dataSourceType = flag.String("data-source", "", "Data Source type")
-	resourceType   = flag.String("resource", "", "Resource type")
+	dataSourceType  = flag.String("data-source", "", "Data Source type")
+	providerVersion = flag.String("provider-version", "", "Version constraint for the Plugin SDK v2 provider used in the generated migration acceptance test")
+	resourceType    = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type> [-provider-version <version-constraint>]|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -51,10 +54,19 @@ func main() {
 	// 	ErrorWriter: os.Stderr,
 	// }
 	g := common.NewGenerator()
+
+	service, err := findServiceRecord(packageName)
+
+	if err != nil {
+		g.Fatalf(err.Error())
+	}
+
 	migrator := &migrator{
-		Generator:   g,
-		Name:        name,
-		PackageName: packageName,
+		Generator:       g,
+		Name:            name,
+		PackageName:     packageName,
+		ProviderVersion: *providerVersion,
+		Service:         service,
 	}
 
 	p, err := provider.New(context.Background())
@@ -83,6 +95,7 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -91,14 +104,34 @@ func main() {
 	}
 }
 
+// findServiceRecord returns the service data for the specified provider package name.
+func findServiceRecord(packageName string) (data.ServiceRecord, error) {
+	services, err := data.ReadAllServiceData()
+
+	if err != nil {
+		return data.ServiceRecord{}, fmt.Errorf("reading service data: %w", err)
+	}
+
+	for _, v := range services {
+		if v.ProviderPackage() == packageName {
+			return v, nil
+		}
+	}
+
+	return data.ServiceRecord{}, fmt.Errorf("service package %s not found", packageName)
+}
+
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	Generator       *common.Generator
+	IsDataSource    bool
+	Name            string
+	PackageName     string
+	ProviderVersion string
+	Resource        *schema.Resource
+	Service         data.ServiceRecord
+	Template        string
+	TestTemplate    string
+	TFTypeName      string
 }
 
 // migrate generates an identical schema into the specified output file.
@@ -125,6 +158,25 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	// The generated test uses the Plugin SDK v2 resource's existing acceptance test helpers, so don't overwrite them.
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_migrate_test.go"
+
+	m.infof("generating acceptance test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.BufferTemplate("test", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
@@ -144,24 +196,45 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	hasTags := !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap
+	tagsIdentifierAttribute := "id"
+	if emitter.HasTopLevelARN {
+		tagsIdentifierAttribute = "arn"
+	}
+
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
+		AWSSDKPackage:                m.Service.GoV2Package(),
+		DefaultCreateTimeout:         durationExpr(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:           durationExpr(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:         durationExpr(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:         durationExpr(emitter.DefaultDeleteTimeout),
+		EmitResourceCustomizeDiff:    m.Resource.CustomizeDiff != nil,
 		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		EmitResourceModifyPlan:       hasTags || m.Resource.CustomizeDiff != nil,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTags:                      hasTags,
 		HasTimeouts:                  emitter.HasTimeouts,
+		HumanName:                    m.Service.HumanFriendly() + " " + naming.ToHumanName(m.Name),
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportTags:                   emitter.ImportTags,
+		ImportTime:                   emitter.DefaultCreateTimeout > 0 || emitter.DefaultReadTimeout > 0 || emitter.DefaultUpdateTimeout > 0 || emitter.DefaultDeleteTimeout > 0,
+		Models:                       emitter.Models,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
+		ProviderNameUpper:            m.Service.ProviderNameUpper(),
+		ProviderVersion:              m.ProviderVersion,
+		ResourceName:                 naming.ToHumanName(m.Name),
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
+		TagsIdentifierAttribute:      tagsIdentifierAttribute,
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	for _, v := range m.Resource.StateUpgraders {
+		templateData.StateUpgraderVersions = append(templateData.StateUpgraderVersions, v.Version)
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -186,20 +259,23 @@ func (m *migrator) infof(format string, a ...interface{}) {
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	HasTopLevelARN                bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
 	IsDataSource                  bool
+	Models                        []model // Nested block models.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
@@ -220,16 +296,16 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = *v
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = *v
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = *v
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = *v
 		}
 	}
 
@@ -262,8 +338,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -285,10 +359,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -296,10 +367,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
-
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -320,6 +388,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -327,6 +396,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -346,6 +416,49 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
+	if isTopLevelAttribute && attributeName == "arn" {
+		e.HasTopLevelARN = true
+	}
+
+	// Standard resource attributes.
+	if isTopLevelAttribute && !e.IsDataSource {
+		switch {
+		case attributeName == "arn" && property.Type == schema.TypeString && isComputedOnly:
+			fprintf(e.SchemaWriter, "framework.ARNAttributeComputedOnly()")
+			fprintf(e.StructWriter, "types.String")
+
+			return nil
+
+		case attributeName == "id" && property.Type == schema.TypeString && isComputedOnly:
+			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
+
+			return nil
+
+		case attributeName == "tags" && property.Type == schema.TypeMap:
+			e.HasTopLevelTagsMap = true
+			e.ImportTags = true
+
+			if property.Optional {
+				fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+			} else {
+				fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			}
+			fprintf(e.StructWriter, "tftags.Map")
+
+			return nil
+
+		case attributeName == "tags_all" && property.Type == schema.TypeMap:
+			e.HasTopLevelTagsAllMap = true
+			e.ImportTags = true
+
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			fprintf(e.StructWriter, "tftags.Map")
+
+			return nil
+		}
+	}
+
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
 	//
@@ -354,9 +467,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +475,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +483,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +496,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +504,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +521,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +532,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +543,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -631,10 +728,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := nestedModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -654,10 +758,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := nestedModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -729,6 +840,28 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedModel emits the model struct for a nested block.
+// Fields emitted by f are written to the nested model struct.
+func (e *emitter) emitNestedModel(name string, f func() error) error {
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+	defer func() {
+		e.StructWriter = structWriter
+	}()
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	e.Models = append(e.Models, model{
+		Name:   name,
+		Struct: sbStruct.String(),
+	})
+
+	return nil
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -895,37 +1028,79 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// nestedModelName returns the name of the model struct for the nested block at the specified path, e.g. "healthCheckConfigModel".
+func nestedModelName(path []string) string {
+	return naming.ToLowerCamelCase(strings.Join(path, "_")) + "Model"
+}
+
+// durationExpr returns a Go expression for the specified duration, e.g. "20 * time.Minute".
+// An empty string is returned for a zero duration.
+func durationExpr(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return ""
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	AWSSDKPackage                 string // e.g. ec2
+	DefaultCreateTimeout          string // e.g. 10 * time.Minute
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	EmitResourceCustomizeDiff     bool
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasTags                       bool
 	HasTimeouts                   bool
+	HumanName                     string // e.g. EC2 Instance
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
+	ImportTime                    bool
+	Models                        []model
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	ProviderNameUpper             string // e.g. EC2
+	ProviderVersion               string // e.g. 5.80.0
+	ResourceName                  string // e.g. Instance
 	Schema                        string
+	StateUpgraderVersions         []int
 	Struct                        string
+	TagsIdentifierAttribute       string // e.g. arn
 	TFTypeName                    string // e.g. aws_instance
 }
 
+type model struct {
+	Name   string // e.g. rootBlockDeviceModel
+	Struct string
+}
+
 //go:embed datasource.gtpl
 var datasourceImpl string
 
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed resource_test.gtpl
+var resourceTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string
@@ -45,6 +45,45 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+func ToLowerCamelCase(s string) string {
+	s = ToCamelCase(s)
+
+	switch s {
+	case "ARN", "ID":
+		return strings.ToLower(s)
+	}
+
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// ToHumanName converts a CamelCase string to space-separated words, e.g. "JobQueue" to "Job Queue".
+// Runs of capital letters are treated as initialisms, e.g. "VPCEndpoint" to "VPC Endpoint".
+func ToHumanName(s string) string {
+	c := strings.Builder{}
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		if i > 0 && isCapitalLetter(ch) {
+			prev := s[i-1]
+			nextIsLow := i+1 < len(s) && isLowercaseLetter(s[i+1])
+
+			if isLowercaseLetter(prev) || isNumeric(prev) || (isCapitalLetter(prev) && nextIsLow) {
+				c.WriteByte(' ')
+			}
+		}
+
+		c.WriteByte(ch)
+	}
+
+	return c.String()
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }